@@ -60,6 +60,8 @@ var (
 		"The HTTP Client read timeout for EventListener Server.")
 	periodSeconds    = flag.Int("period-seconds", elresources.DefaultPeriodSeconds, "The Period Seconds for the EventListener Liveness and Readiness Probes.")
 	failureThreshold = flag.Int("failure-threshold", elresources.DefaultFailureThreshold, "The Failure Threshold for the EventListener Liveness and Readiness Probes.")
+	authOverride     = flag.String("el-auth-override", elresources.DefaultAuthOverride,
+		"How the EventListener authenticates as the service accounts of the Triggers, either impersonation or tokenrequest.")
 
 	staticResourceLabels = elresources.DefaultStaticResourceLabels
 	systemNamespace      = os.Getenv("SYSTEM_NAMESPACE")
@@ -85,6 +87,7 @@ func main() {
 		HTTPClientExpectContinueTimeout: httpClientExpectContinueTimeout,
 		PeriodSeconds:                   periodSeconds,
 		FailureThreshold:                failureThreshold,
+		AuthOverride:                    authOverride,
 
 		StaticResourceLabels: staticResourceLabels,
 		SystemNamespace:      systemNamespace,
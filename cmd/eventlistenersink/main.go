@@ -19,6 +19,8 @@ package main
 import (
 	"context"
 	"log"
+	// The time zones of the schedules of Triggers are loaded from the binary
+	_ "time/tzdata"
 
 	"k8s.io/client-go/dynamic"
 	kubeclientset "k8s.io/client-go/kubernetes"
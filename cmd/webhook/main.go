@@ -19,6 +19,8 @@ package main
 import (
 	"context"
 	"os"
+	// The time zones of the schedules of Triggers are loaded from the binary
+	_ "time/tzdata"
 
 	defaultconfig "github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/contexts"
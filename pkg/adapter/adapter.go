@@ -25,7 +25,10 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	clusterinterceptorsinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor"
@@ -37,13 +40,18 @@ import (
 	triggertemplatesinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/pkg/client/clientset/versioned/scheme"
+	"github.com/tektoncd/triggers/pkg/interceptors"
+	"github.com/tektoncd/triggers/pkg/interceptors/server"
+	"github.com/tektoncd/triggers/pkg/resources"
 	"github.com/tektoncd/triggers/pkg/sink"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/eventing/pkg/adapter/v2"
@@ -55,6 +63,14 @@ import (
 
 type envConfig struct {
 	adapter.EnvConfig
+
+	// ReplayToken is the bearer token of the admin endpoint
+	ReplayToken string `envconfig:"EL_REPLAY_TOKEN"`
+	// DebugToken is the bearer token of the debug endpoints
+	DebugToken string `envconfig:"EL_DEBUG_TOKEN"`
+	// AuthClientCA holds the PEM encoded certificates of the CAs that verify
+	// client certificates
+	AuthClientCA string `envconfig:"EL_AUTH_CLIENT_CA"`
 }
 
 func NewEnvConfig() adapter.EnvConfigAccessor {
@@ -64,12 +80,24 @@ func NewEnvConfig() adapter.EnvConfigAccessor {
 var (
 	interval = 10 * time.Second
 	timeout  = 1 * time.Minute
+
+	// deliveryCleanupInterval is how often the Leases recording expired
+	// deliveries are deleted
+	deliveryCleanupInterval = 5 * time.Minute
+
+	// triggerRunCleanupInterval is how often the expired TriggerRuns are deleted
+	triggerRunCleanupInterval = 5 * time.Minute
 )
 
 // sinker implements the adapter for an event listener.
 type sinker struct {
-	Logger    *zap.SugaredLogger
-	Namespace string
+	Logger      *zap.SugaredLogger
+	Namespace   string
+	ReplayToken string
+	DebugToken  string
+	// AuthTokens are the bearer tokens or API keys accepted
+	AuthTokens   []string
+	AuthClientCA string
 
 	Args     sink.Args
 	Clients  sink.Clients
@@ -158,6 +186,10 @@ func (s *sinker) getCertFromInterceptor(certPool *x509.CertPool) error {
 		}
 
 		for i := range clusterInterceptorList {
+			// The core interceptors running in-process are not called over HTTPS
+			if s.Args.InProcessInterceptors && sink.IsCoreInterceptor(clusterInterceptorList[i]) {
+				continue
+			}
 			if v, k := clusterInterceptorList[i].Labels["server/type"]; k && v == "https" {
 				httpsCILen++
 				if !bytes.Equal(clusterInterceptorList[i].Spec.ClientConfig.CaBundle, []byte{}) {
@@ -205,18 +237,125 @@ func (s *sinker) getCertFromInterceptor(certPool *x509.CertPool) error {
 	return nil
 }
 
+// authTokensFromEnv returns the tokens set in the EL_AUTH_TOKEN_<n>
+// environment variables, from 0 until the first unset variable.
+func authTokensFromEnv() []string {
+	var tokens []string
+	for i := 0; ; i++ {
+		token, ok := os.LookupEnv(fmt.Sprintf("EL_AUTH_TOKEN_%d", i))
+		if !ok {
+			return tokens
+		}
+		tokens = append(tokens, token)
+	}
+}
+
+// authentication returns the Authentication of the requests, or nil if they
+// are not authenticated.
+func (s *sinker) authentication() *sink.Authentication {
+	if !s.Args.Authentication {
+		return nil
+	}
+	return &sink.Authentication{
+		Tokens:               s.AuthTokens,
+		APIKeyHeader:         s.Args.AuthAPIKeyHeader,
+		ClientCertificates:   s.AuthClientCA != "",
+		TokenReview:          s.Args.AuthTokenReview,
+		TokenReviewAudiences: s.Args.AuthTokenReviewAudiences,
+		TokenReviewUsers:     s.Args.AuthTokenReviewUsers,
+		TokenReviews:         sink.NewTokenReviewCache(sink.DefaultTokenReviewTTL, sink.DefaultTokenReviewQPS, sink.DefaultTokenReviewBurst),
+	}
+}
+
+// authOverride returns the AuthOverride creating the clients of the service
+// accounts of the Triggers, which are cached for DefaultClientTTL.
+func (s *sinker) authOverride(kubeClient kubernetes.Interface) sink.AuthOverride {
+	clients := sink.NewClientPool(sink.DefaultClientTTL)
+	if s.Args.AuthOverride == sink.AuthOverrideTokenRequest {
+		return sink.TokenRequestAuthOverride{KubeClientSet: kubeClient, Clients: clients}
+	}
+	return sink.DefaultAuthOverride{Clients: clients}
+}
+
+// coreInterceptors returns the core interceptors running in the EventListener
+// process, or nil if they are called over HTTP. Their secrets are read with
+// the credentials of the EventListener.
+func (s *sinker) coreInterceptors(kubeClient kubernetes.Interface) (sink.CoreInterceptors, error) {
+	if !s.Args.InProcessInterceptors {
+		return nil, nil
+	}
+	coreInterceptors, err := server.NewWithCoreInterceptors(interceptors.DefaultSecretGetter(kubeClient.CoreV1()), s.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the core interceptors: %w", err)
+	}
+	return coreInterceptors, nil
+}
+
+// clientCertificatesTLSConfig returns the TLS configuration verifying the
+// client certificates with the AuthClientCA, or nil if it is not set.
+// Requests without a client certificate are still served, so that they can
+// be authenticated with the other methods.
+func (s *sinker) clientCertificatesTLSConfig() (*tls.Config, error) {
+	if s.AuthClientCA == "" {
+		return nil, nil
+	}
+	if s.Args.Cert == "" || s.Args.Key == "" {
+		s.Logger.Warn("Client certificates cannot be verified since the EventListener does not serve HTTPS")
+		return nil, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(s.AuthClientCA)) {
+		return nil, errors.New("unable to parse the certificates of the client CA")
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
 func (s *sinker) Start(ctx context.Context) error {
 	clientObj, err := s.getHTTPClient()
 	if err != nil {
 		return err
 	}
+	tlsConfig, err := s.clientCertificatesTLSConfig()
+	if err != nil {
+		return err
+	}
+	coreInterceptors, err := s.coreInterceptors(kubeclient.Get(ctx))
+	if err != nil {
+		return err
+	}
 	// Create EventListener Sink
 
 	dynamicClient := dynamicclient.Get(ctx)
+	deliveryStore := sink.NewLeaseDeliveryStore(kubeclient.Get(ctx), s.Args.ElName, s.Args.ElNamespace)
+	elLister := eventlistenerinformer.Get(s.injCtx).Lister() //nolint:contextcheck
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		el, err := elLister.EventListeners(s.Args.ElNamespace).Get(s.Args.ElName)
+		if err != nil {
+			return
+		}
+		// The changes of the watch sources are recorded as deliveries too
+		watches := slices.ContainsFunc(el.Spec.Sources, func(s triggersv1beta1.EventSource) bool { return s.Watch != nil })
+		if el.Spec.Deduplication == nil && !watches {
+			return
+		}
+		if err := deliveryStore.DeleteExpired(ctx); err != nil {
+			s.Logger.Warnf("failed to delete expired deliveries: %v", err)
+		}
+	}, deliveryCleanupInterval)
+
+	// The hostname of a pod is its name, under Knative as well
+	podName, err := os.Hostname()
+	if err != nil {
+		s.Logger.Warnf("failed to get the name of the pod: %v", err)
+	}
 
 	r := sink.Sink{
 		KubeClientSet:          kubeclient.Get(ctx),
-		DiscoveryClient:        s.Clients.DiscoveryClient,
+		DiscoveryClient:        resources.NewCachedDiscovery(s.Clients.DiscoveryClient, resources.DefaultRefreshInterval),
 		DynamicClient:          dynamicClient,
 		TriggersClient:         s.Clients.TriggersClient,
 		HTTPClient:             clientObj,
@@ -224,11 +363,26 @@ func (s *sinker) Start(ctx context.Context) error {
 		EventListenerName:      s.Args.ElName,
 		EventListenerNamespace: s.Args.ElNamespace,
 		PayloadValidation:      s.Args.PayloadValidation,
+		MaxBodySize:            s.Args.MaxBodySize,
+		Authentication:         s.authentication(),
+		CoreInterceptors:       coreInterceptors,
 		Logger:                 s.Logger,
 		Recorder:               s.Recorder,
 		CloudEventURI:          s.Args.CloudEventURI,
-		Auth:                   sink.DefaultAuthOverride{},
+		SyncResponseTimeout:    s.Args.SyncResponseTimeout * time.Second, //nolint:durationcheck
+		DeliveryStore:          deliveryStore,
+		Workers:                sink.NewWorkerPool(s.Args.MaxConcurrency, s.Args.QueueSize),
+		RetryAfter:             s.Args.RetryAfter * time.Second, //nolint:durationcheck
+		EventStore:             sink.NewEventStore(s.Args.ReplayMaxEvents, s.Args.ReplayRedactHeaders),
+		AdminToken:             s.ReplayToken,
+		Decisions:              sink.NewDecisionStore(s.Args.DebugMaxDecisions),
+		DebugToken:             s.DebugToken,
+		PodName:                podName,
+		ConcurrencyGroups:      sink.NewConcurrencyGroups(),
+		Debouncer:              sink.NewDebouncer(),
+		Auth:                   s.authOverride(kubeclient.Get(ctx)),
 		WGProcessTriggers:      &sync.WaitGroup{},
+		InFlight:               sink.NewInFlightEvents(),
 		EventRecorder:          s.createRecorder(s.injCtx, "EventListener"), //nolint:contextcheck
 
 		// Register all the listers we'll need
@@ -241,12 +395,36 @@ func (s *sinker) Start(ctx context.Context) error {
 		InterceptorLister:           interceptorsinformer.Get(s.injCtx).Lister(),           //nolint:contextcheck
 	}
 
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		el, err := elLister.EventListeners(s.Args.ElNamespace).Get(s.Args.ElName)
+		if err != nil || el.Spec.TriggerRuns == nil {
+			return
+		}
+		if err := r.DeleteExpiredTriggerRuns(ctx, el.Spec.TriggerRuns.GetTTL()); err != nil {
+			s.Logger.Warnf("failed to delete expired TriggerRuns: %v", err)
+		}
+	}, triggerRunCleanupInterval)
+
+	go r.RunSchedules(ctx)
+	go r.RunGitSources(ctx)
+	go r.RunWatchSources(ctx)
+	go r.RunMessageSources(ctx)
+
 	mux := http.NewServeMux()
 	eventHandler := http.HandlerFunc(r.HandleEvent)
-	metricsRecorder := &sink.MetricsHandler{Handler: r.IsValidPayload(eventHandler)}
+	metricsRecorder := &sink.MetricsHandler{Handler: r.Authenticate(r.DecodeBody(r.IsValidPayload(eventHandler)))}
 
 	mux.HandleFunc("/", metricsRecorder.Intercept(r.NewMetricsRecorderInterceptor()))
 
+	// The admin and debug endpoints are only served when they are enabled,
+	// so that the requests to their paths otherwise reach the Triggers
+	if r.EventStore != nil && r.AdminToken != "" {
+		mux.Handle("/admin/", r.AdminHandler())
+	}
+	if r.Decisions != nil && r.DebugToken != "" {
+		mux.Handle("/debug/", r.DebugHandler())
+	}
+
 	// For handling Liveness Probe
 	// TODO(dibyom): Livness, metrics etc. should be on a separate port
 	mux.HandleFunc("/live", func(w http.ResponseWriter, _ *http.Request) {
@@ -254,24 +432,61 @@ func (s *sinker) Start(ctx context.Context) error {
 		fmt.Fprint(w, "ok")
 	})
 
+	// For handling Readiness Probe, which fails once the EventListener shuts down
+	var shuttingDown atomic.Bool
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, _ *http.Request) {
+		if shuttingDown.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "shutting down")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "ok")
+	})
+
 	srv := &http.Server{
 		Addr:              ":" + s.Args.Port,
 		ReadHeaderTimeout: s.Args.ELReadTimeOut * time.Second,  //nolint:durationcheck
 		ReadTimeout:       s.Args.ELReadTimeOut * time.Second,  //nolint:durationcheck
 		WriteTimeout:      s.Args.ELWriteTimeOut * time.Second, //nolint:durationcheck
 		IdleTimeout:       s.Args.ELIdleTimeOut * time.Second,  //nolint:durationcheck
+		TLSConfig:         tlsConfig,
 		Handler: http.TimeoutHandler(mux,
 			s.Args.ELTimeOutHandler*time.Second, "EventListener Timeout!\n"), //nolint:durationcheck
 	}
 
-	if s.Args.Cert == "" && s.Args.Key == "" {
-		if err := srv.ListenAndServe(); err != nil {
-			return err
-		}
-	} else {
-		if err := srv.ListenAndServeTLS(s.Args.Cert, s.Args.Key); err != nil {
-			return err
+	serveErr := make(chan error, 1)
+	go func() {
+		if s.Args.Cert == "" && s.Args.Key == "" {
+			serveErr <- srv.ListenAndServe()
+		} else {
+			serveErr <- srv.ListenAndServeTLS(s.Args.Cert, s.Args.Key)
 		}
+	}()
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Fail the readiness probe, and keep accepting requests until the replica
+	// is removed from the endpoints of the Service
+	shuttingDown.Store(true)
+	delay := s.Args.ShutdownDelay
+	s.Logger.Infof("Shutting down, accepting requests for %s until the readiness probe fails", delay)
+	time.Sleep(delay)
+
+	// Stop accepting requests and wait for the events being processed,
+	// including the events accepted by the requests in progress
+	gracePeriod := s.Args.ShutdownGracePeriod
+	s.Logger.Infof("Stopping accepting requests, waiting up to %s for the events being processed", gracePeriod)
+	drainCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), gracePeriod)
+	defer cancel()
+	if err := srv.Shutdown(drainCtx); err != nil {
+		s.Logger.Warnf("Failed to wait for the requests in progress: %v", err)
+	}
+	if abandoned := r.Drain(drainCtx); len(abandoned) > 0 {
+		s.Logger.Errorw("Abandoned the processing of events on shutdown", zap.Strings("eventIDs", abandoned))
 	}
 	return nil
 }
@@ -282,12 +497,17 @@ func New(sinkArgs sink.Args, sinkClients sink.Clients, recorder *sink.Recorder)
 		logger := logging.FromContext(ctx)
 
 		return &sinker{
-			Logger:    logger,
-			Namespace: env.Namespace,
-			Args:      sinkArgs,
-			Clients:   sinkClients,
-			Recorder:  recorder,
-			injCtx:    ctx,
+			Logger:      logger,
+			Namespace:   env.Namespace,
+			ReplayToken: env.ReplayToken,
+			DebugToken:  env.DebugToken,
+			// The number of tokens varies, so they are not part of envConfig
+			AuthTokens:   authTokensFromEnv(),
+			AuthClientCA: env.AuthClientCA,
+			Args:         sinkArgs,
+			Clients:      sinkClients,
+			Recorder:     recorder,
+			injCtx:       ctx,
 		}
 	}
 }
@@ -15,4 +15,12 @@ const (
 
 	// TriggerGroupLabelKey is used as a label identifier for a TriggerGroup
 	TriggerGroupLabelKey = "/triggergroup"
+
+	// DeliveryLabelKey is used as the label identifier for the Leases
+	// recording the deliveries received by an EventListener
+	DeliveryLabelKey = "/delivery"
+
+	// ConcurrencyGroupLabelKey is used as the label identifier for the
+	// concurrency group of the resources created by a Trigger
+	ConcurrencyGroupLabelKey = "/concurrency-group"
 )
@@ -60,6 +60,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TriggerTemplateList{},
 		&Trigger{},
 		&TriggerList{},
+		&TriggerRun{},
+		&TriggerRunList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// TriggerRunReasonResourcesCreated is the reason of a TriggerRun whose
+	// resources were created.
+	TriggerRunReasonResourcesCreated = "ResourcesCreated"
+	// TriggerRunReasonInterceptorStopped is the reason of a TriggerRun whose
+	// event was filtered out by an interceptor.
+	TriggerRunReasonInterceptorStopped = "InterceptorStopped"
+	// TriggerRunReasonSkipped is the reason of a TriggerRun whose resources
+	// were not created because its concurrency group was running.
+	TriggerRunReasonSkipped = "Skipped"
+	// TriggerRunReasonSuperseded is the reason of a TriggerRun whose resources
+	// were not created because a newer event with the same debounce key was received.
+	TriggerRunReasonSuperseded = "Superseded"
+	// TriggerRunReasonFailed is the reason of a TriggerRun that failed.
+	TriggerRunReasonFailed = "Failed"
+	// TriggerRunReasonRunning is the reason of a TriggerRun being processed.
+	TriggerRunReasonRunning = "Running"
+)
+
+var triggerRunCondSet = apis.NewBatchConditionSet()
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TriggerRun records how an EventListener processed an event for a Trigger.
+// TriggerRuns are created by the EventListener sink, which owns them.
+type TriggerRun struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TriggerRunSpec `json:"spec"`
+	// +optional
+	Status TriggerRunStatus `json:"status"`
+}
+
+// TriggerRunSpec identifies the event and the Trigger of a TriggerRun.
+type TriggerRunSpec struct {
+	// EventListener is the name of the EventListener that received the event.
+	EventListener string `json:"eventListener"`
+	// EventID is the ID of the event.
+	EventID string `json:"eventID"`
+	// Trigger is the name of the Trigger.
+	Trigger string `json:"trigger"`
+	// TriggerNamespace is the namespace of the Trigger.
+	// +optional
+	TriggerNamespace string `json:"triggerNamespace,omitempty"`
+	// TriggerGroup is the name of the TriggerGroup that selected the Trigger, if any.
+	// +optional
+	TriggerGroup string `json:"triggerGroup,omitempty"`
+}
+
+// TriggerRunStatus records what happened to the event.
+// +k8s:deepcopy-gen=true
+type TriggerRunStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// StartTime is the time the EventListener started to process the Trigger.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the EventListener finished to process the Trigger.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Interceptors are the results of the interceptors of the Trigger, in the
+	// order they were called.
+	// +optional
+	// +listType=atomic
+	Interceptors []InterceptorResult `json:"interceptors,omitempty"`
+	// Params are the params resolved from the event.
+	// +optional
+	// +listType=atomic
+	Params []Param `json:"params,omitempty"`
+	// Resources are the resources created for the event.
+	// +optional
+	// +listType=atomic
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// InterceptorResult is the result of an interceptor called for a TriggerRun.
+type InterceptorResult struct {
+	// Name is the name of the interceptor, or "webhook" for webhook interceptors.
+	Name string `json:"name"`
+	// Continue is true if the interceptor let the event through.
+	Continue bool `json:"continue"`
+	// Message explains why the interceptor stopped the event, or the error
+	// that occurred calling it.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ResourceReference identifies a resource created for a TriggerRun.
+type ResourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TriggerRunList contains a list of TriggerRun
+type TriggerRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TriggerRun `json:"items"`
+}
+
+// GetGroupVersionKind returns the GroupVersionKind of TriggerRun.
+func (tr *TriggerRun) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("TriggerRun")
+}
+
+// GetCondition returns the Condition matching the given type.
+func (trs *TriggerRunStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return triggerRunCondSet.Manage(trs).GetCondition(t)
+}
+
+// InitializeConditions marks the TriggerRun as running.
+func (trs *TriggerRunStatus) InitializeConditions() {
+	triggerRunCondSet.Manage(trs).InitializeConditions()
+	triggerRunCondSet.Manage(trs).MarkUnknown(apis.ConditionSucceeded, TriggerRunReasonRunning, "")
+}
+
+// MarkSucceeded marks the TriggerRun as succeeded.
+func (trs *TriggerRunStatus) MarkSucceeded(reason, messageFormat string, messageA ...interface{}) {
+	triggerRunCondSet.Manage(trs).MarkTrueWithReason(apis.ConditionSucceeded, reason, messageFormat, messageA...)
+}
+
+// MarkFailed marks the TriggerRun as failed.
+func (trs *TriggerRunStatus) MarkFailed(reason, messageFormat string, messageA ...interface{}) {
+	triggerRunCondSet.Manage(trs).MarkFalse(apis.ConditionSucceeded, reason, messageFormat, messageA...)
+}
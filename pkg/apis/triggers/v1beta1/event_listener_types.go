@@ -18,9 +18,12 @@ package v1beta1
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -67,6 +70,427 @@ type EventListenerSpec struct {
 	LabelSelector     *metav1.LabelSelector       `json:"labelSelector,omitempty"`
 	Resources         Resources                   `json:"resources,omitempty"`
 	CloudEventURI     string                      `json:"cloudEventURI,omitempty"`
+	// Deduplication optionally skips repeated deliveries of the same event,
+	// such as webhooks retried by the sender
+	// +optional
+	Deduplication *Deduplication `json:"deduplication,omitempty"`
+	// Workers optionally bounds the number of Triggers and TriggerGroups
+	// processed concurrently by each EventListener replica
+	// +optional
+	Workers *Workers `json:"workers,omitempty"`
+	// RetryPolicy optionally retries the creation of resources that failed
+	// for the Triggers that do not specify a retry policy
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Replay optionally keeps the most recent events received by the
+	// EventListener so that they can be listed and replayed.
+	// +optional
+	Replay *Replay `json:"replay,omitempty"`
+	// TriggerRuns optionally records a TriggerRun for each event processed
+	// by each Trigger.
+	// +optional
+	TriggerRuns *TriggerRuns `json:"triggerRuns,omitempty"`
+	// Sources optionally generate events processed by the Triggers of the
+	// EventListener, in addition to the events it receives.
+	// +listType=atomic
+	// +optional
+	Sources []EventSource `json:"sources,omitempty"`
+	// MaxBodySize optionally limits the size of the bodies of the requests
+	// received by the EventListener, before and after their decompression.
+	// Larger requests are rejected.
+	// +optional
+	MaxBodySize *resource.Quantity `json:"maxBodySize,omitempty"`
+	// ShutdownGracePeriod is how long a terminating EventListener replica
+	// waits for the events it accepted to be processed. Defaults to 25s.
+	// +optional
+	ShutdownGracePeriod *metav1.Duration `json:"shutdownGracePeriod,omitempty"`
+	// ShutdownDelay is how long a terminating EventListener replica keeps
+	// accepting requests, while failing its readiness probe, so that it is
+	// removed from the endpoints of its Service before it stops accepting
+	// them. It should be longer than the period of the readiness probe.
+	// Defaults to 15s. It is ignored by EventListeners with a CustomResource,
+	// which do not delay their shutdown.
+	// +optional
+	ShutdownDelay *metav1.Duration `json:"shutdownDelay,omitempty"`
+	// Debug optionally exposes the debug endpoints of the EventListener,
+	// which describe the Triggers it resolves and the decisions they made.
+	// +optional
+	Debug *Debug `json:"debug,omitempty"`
+	// Authentication optionally authenticates the requests received by the
+	// EventListener before they are processed by its Triggers.
+	// +optional
+	Authentication *Authentication `json:"authentication,omitempty"`
+	// Response optionally configures how the EventListener responds to the
+	// requests that do not select a response mode.
+	// +optional
+	Response *Response `json:"response,omitempty"`
+}
+
+// DefaultShutdownGracePeriod is the ShutdownGracePeriod of EventListeners that
+// do not specify one.
+const DefaultShutdownGracePeriod = 25 * time.Second
+
+// GetShutdownGracePeriod returns the ShutdownGracePeriod, or
+// DefaultShutdownGracePeriod if it is not set.
+func (s *EventListenerSpec) GetShutdownGracePeriod() time.Duration {
+	if s.ShutdownGracePeriod == nil {
+		return DefaultShutdownGracePeriod
+	}
+	return s.ShutdownGracePeriod.Duration
+}
+
+// DefaultShutdownDelay is the ShutdownDelay of EventListeners that do not
+// specify one. It is longer than the default period of the readiness probe.
+const DefaultShutdownDelay = 15 * time.Second
+
+// GetShutdownDelay returns the ShutdownDelay, or DefaultShutdownDelay if it is
+// not set.
+func (s *EventListenerSpec) GetShutdownDelay() time.Duration {
+	if s.ShutdownDelay == nil {
+		return DefaultShutdownDelay
+	}
+	return s.ShutdownDelay.Duration
+}
+
+// DefaultDeduplicationTTL is how long a delivery is remembered when
+// Deduplication does not specify a TTL.
+const DefaultDeduplicationTTL = time.Hour
+
+// Deduplication configures how an EventListener identifies repeated deliveries
+// of the same event.
+type Deduplication struct {
+	// Key identifies a delivery. It uses the same syntax as binding values,
+	// e.g. $(header.X-Github-Delivery) or $(body.event_id).
+	Key string `json:"key"`
+	// TTL is how long a delivery is remembered. Defaults to 1h.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// GetTTL returns the TTL, or DefaultDeduplicationTTL if it is not set.
+func (d *Deduplication) GetTTL() time.Duration {
+	if d.TTL == nil {
+		return DefaultDeduplicationTTL
+	}
+	return d.TTL.Duration
+}
+
+const (
+	// DefaultWorkersQueueSize is the queue size used when Workers does not specify one.
+	DefaultWorkersQueueSize = 100
+	// DefaultWorkersRetryAfter is the Retry-After delay used when Workers does not specify one.
+	DefaultWorkersRetryAfter = 10 * time.Second
+)
+
+// Workers configures how many Triggers and TriggerGroups an EventListener
+// replica processes at the same time, and how many can wait to be processed.
+type Workers struct {
+	// MaxConcurrency is the maximum number of Triggers and TriggerGroups
+	// processed at the same time.
+	MaxConcurrency int32 `json:"maxConcurrency"`
+	// QueueSize is the maximum number of Triggers and TriggerGroups waiting
+	// for a worker. Requests are rejected when the queue is full. Defaults to 100.
+	// +optional
+	QueueSize *int32 `json:"queueSize,omitempty"`
+	// RetryAfter is the delay sent in the Retry-After header of rejected
+	// requests. Defaults to 10s.
+	// +optional
+	RetryAfter *metav1.Duration `json:"retryAfter,omitempty"`
+}
+
+// GetQueueSize returns the QueueSize, or DefaultWorkersQueueSize if it is not set.
+func (w *Workers) GetQueueSize() int32 {
+	if w.QueueSize == nil {
+		return DefaultWorkersQueueSize
+	}
+	return *w.QueueSize
+}
+
+// GetRetryAfter returns the RetryAfter delay, or DefaultWorkersRetryAfter if it is not set.
+func (w *Workers) GetRetryAfter() time.Duration {
+	if w.RetryAfter == nil {
+		return DefaultWorkersRetryAfter
+	}
+	return w.RetryAfter.Duration
+}
+
+// DefaultReplayMaxEvents is the number of events kept when Replay does not
+// specify one.
+const DefaultReplayMaxEvents = 100
+
+// Replay configures the events an EventListener replica keeps in memory, and
+// the admin endpoint used to list and replay them.
+type Replay struct {
+	// MaxEvents is the number of most recent events kept. Defaults to 100.
+	// +optional
+	MaxEvents *int32 `json:"maxEvents,omitempty"`
+	// RedactHeaders are headers redacted from the listed events, in addition
+	// to the headers that usually hold credentials or signatures.
+	// +optional
+	RedactHeaders []string `json:"redactHeaders,omitempty"`
+	// TokenRef refers to the key of a Secret, in the namespace of the
+	// EventListener, holding the bearer token of the admin endpoint.
+	TokenRef SecretRef `json:"tokenRef"`
+}
+
+// GetMaxEvents returns MaxEvents, or DefaultReplayMaxEvents if it is not set.
+func (r *Replay) GetMaxEvents() int32 {
+	if r.MaxEvents == nil {
+		return DefaultReplayMaxEvents
+	}
+	return *r.MaxEvents
+}
+
+// Authentication configures how an EventListener authenticates the requests it
+// receives. A request is accepted when any of the configured methods
+// authenticates it.
+type Authentication struct {
+	// TokenRefs refer to the keys of Secrets, in the namespace of the
+	// EventListener, holding the bearer tokens or API keys accepted.
+	// +listType=atomic
+	// +optional
+	TokenRefs []SecretRef `json:"tokenRefs,omitempty"`
+	// APIKeyHeader is the header holding an API key, e.g. X-Api-Key. The
+	// tokens are also accepted as bearer tokens in the Authorization header.
+	// +optional
+	APIKeyHeader string `json:"apiKeyHeader,omitempty"`
+	// ClientCARef refers to the key of a Secret, in the namespace of the
+	// EventListener, holding the PEM encoded certificates of the CAs that
+	// verify client certificates. The EventListener must serve HTTPS.
+	// +optional
+	ClientCARef *SecretRef `json:"clientCARef,omitempty"`
+	// TokenReview authenticates Kubernetes bearer tokens, such as the tokens
+	// of ServiceAccounts, with the TokenReview API.
+	// +optional
+	TokenReview *TokenReview `json:"tokenReview,omitempty"`
+}
+
+// TokenReview configures the Kubernetes bearer tokens accepted by an EventListener.
+type TokenReview struct {
+	// Audiences are the audiences the tokens must be issued for. Defaults to
+	// the audiences of the API server.
+	// +listType=atomic
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+	// Users are the users allowed to send requests, e.g.
+	// system:serviceaccount:<namespace>:<name>. The requests of other
+	// authenticated users are forbidden. All authenticated users are allowed
+	// if empty.
+	// +listType=atomic
+	// +optional
+	Users []string `json:"users,omitempty"`
+}
+
+// DefaultDebugMaxDecisions is the number of decisions kept for each Trigger
+// when Debug does not specify MaxDecisions.
+const DefaultDebugMaxDecisions = 10
+
+// Debug configures the debug endpoints of an EventListener.
+type Debug struct {
+	// MaxDecisions is the number of most recent decisions kept for each
+	// Trigger. Defaults to 10.
+	// +optional
+	MaxDecisions *int32 `json:"maxDecisions,omitempty"`
+	// TokenRef refers to the key of a Secret, in the namespace of the
+	// EventListener, holding the bearer token of the debug endpoints.
+	TokenRef SecretRef `json:"tokenRef"`
+}
+
+// GetMaxDecisions returns MaxDecisions, or DefaultDebugMaxDecisions if it is
+// not set.
+func (d *Debug) GetMaxDecisions() int32 {
+	if d.MaxDecisions == nil {
+		return DefaultDebugMaxDecisions
+	}
+	return *d.MaxDecisions
+}
+
+// ResponseMode is how an EventListener responds to a request.
+type ResponseMode string
+
+const (
+	// ResponseModeAsync responds once the Triggers of the event are selected.
+	ResponseModeAsync ResponseMode = "async"
+	// ResponseModeSync responds once the Triggers of the event were processed,
+	// with the outcome of each Trigger.
+	ResponseModeSync ResponseMode = "sync"
+)
+
+// Response configures the responses of an EventListener to the requests that
+// do not select a response mode with the Tekton-Triggers-Response-Mode header
+// or the responseMode query parameter.
+type Response struct {
+	// Mode is the response mode of the requests. Defaults to async.
+	// +optional
+	Mode ResponseMode `json:"mode,omitempty"`
+	// Timeout is how long a synchronous response waits for the Triggers,
+	// including for the requests that select the sync mode. Defaults to the
+	// sync response timeout of the EventListener, 4s. It must be shorter than
+	// the timeout of the handler of the EventListener, 5s by default.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// DefaultTriggerRunsTTL is how long TriggerRuns are kept when TriggerRuns does
+// not specify a TTL.
+const DefaultTriggerRunsTTL = 24 * time.Hour
+
+// TriggerRuns configures the TriggerRuns recorded by an EventListener.
+type TriggerRuns struct {
+	// TTL is how long a TriggerRun is kept after it completed. Defaults to 24h.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// RecordParams records the params resolved from each event in the status
+	// of its TriggerRuns. They are not recorded by default since they may hold
+	// values of the event that must not be readable by every user allowed to
+	// read TriggerRuns.
+	// +optional
+	RecordParams bool `json:"recordParams,omitempty"`
+}
+
+// GetTTL returns the TTL, or DefaultTriggerRunsTTL if it is not set.
+func (t *TriggerRuns) GetTTL() time.Duration {
+	if t.TTL == nil {
+		return DefaultTriggerRunsTTL
+	}
+	return t.TTL.Duration
+}
+
+// EventSource generates events processed by the Triggers of an EventListener.
+type EventSource struct {
+	// Name identifies the source. Its events are sent to the /sources/<name> path.
+	Name string `json:"name"`
+	// Git polls the refs of a Git repository.
+	// +optional
+	Git *GitSource `json:"git,omitempty"`
+	// Watch watches the changes of Kubernetes resources.
+	// +optional
+	Watch *WatchSource `json:"watch,omitempty"`
+	// NATS subscribes to the messages of a NATS server.
+	// +optional
+	NATS *NATSSource `json:"nats,omitempty"`
+}
+
+const (
+	// DefaultGitSourceRef is the ref pattern watched when a GitSource does not specify one.
+	DefaultGitSourceRef = "refs/heads/*"
+	// DefaultGitSourceInterval is how often a GitSource is polled when it does not specify an interval.
+	DefaultGitSourceInterval = time.Minute
+)
+
+// GitSource polls the refs of a Git repository over HTTP(S) or SSH, and
+// generates a push event when one of the watched refs is created, updated or
+// deleted.
+type GitSource struct {
+	// URL of the repository, either an http, https or ssh URL, or the
+	// scp-like syntax of SSH, e.g. git@github.com:tektoncd/triggers.git.
+	URL string `json:"url"`
+	// Refs are the patterns of the watched refs, e.g. refs/heads/main or
+	// refs/tags/v*. Defaults to all the branches.
+	// +listType=atomic
+	// +optional
+	Refs []string `json:"refs,omitempty"`
+	// Interval between two polls of the repository. Defaults to 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// SecretName is the name of a Secret, in the namespace of the
+	// EventListener, holding the credentials of the repository: either the
+	// username and password keys of a kubernetes.io/basic-auth Secret, or the
+	// ssh-privatekey key of a kubernetes.io/ssh-auth Secret, with the
+	// known_hosts key verifying the key of the server.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// GetRefs returns the Refs, or DefaultGitSourceRef if none is set.
+func (g *GitSource) GetRefs() []string {
+	if len(g.Refs) == 0 {
+		return []string{DefaultGitSourceRef}
+	}
+	return g.Refs
+}
+
+// GetInterval returns the Interval, or DefaultGitSourceInterval if it is not set.
+func (g *GitSource) GetInterval() time.Duration {
+	if g.Interval == nil {
+		return DefaultGitSourceInterval
+	}
+	return g.Interval.Duration
+}
+
+// WatchEventType is a type of change of the resources of a WatchSource.
+type WatchEventType string
+
+const (
+	// WatchEventAdd is the creation of a resource.
+	WatchEventAdd WatchEventType = "add"
+	// WatchEventUpdate is the update of a resource.
+	WatchEventUpdate WatchEventType = "update"
+	// WatchEventDelete is the deletion of a resource.
+	WatchEventDelete WatchEventType = "delete"
+)
+
+// WatchSource watches Kubernetes resources, and generates an event for each
+// of their changes.
+type WatchSource struct {
+	// Group of the resources, empty for the core group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Version of the resources, e.g. v1.
+	Version string `json:"version"`
+	// Resource is the plural name of the resources, e.g. pipelineruns.
+	Resource string `json:"resource"`
+	// Namespace of the resources. Defaults to the namespace of the
+	// EventListener. Ignored for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector optionally selects the resources by label.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// FieldSelector optionally selects the resources by field, e.g.
+	// metadata.name=my-config.
+	// +optional
+	FieldSelector string `json:"fieldSelector,omitempty"`
+	// EventTypes are the changes that generate events: add, update or
+	// delete. Defaults to all of them.
+	// +listType=atomic
+	// +optional
+	EventTypes []WatchEventType `json:"eventTypes,omitempty"`
+}
+
+// GroupVersionResource returns the GroupVersionResource of the resources.
+func (w *WatchSource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: w.Group, Version: w.Version, Resource: w.Resource}
+}
+
+// Handles returns true if the changes of the event type generate events.
+func (w *WatchSource) Handles(t WatchEventType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, t)
+}
+
+// NATSSource subscribes to the messages of a NATS server, and generates an
+// event for each of them.
+type NATSSource struct {
+	// URL of the NATS server, e.g. nats://nats.nats-system:4222.
+	URL string `json:"url"`
+	// Subject of the messages, which may contain wildcards, e.g. builds.>.
+	Subject string `json:"subject"`
+	// Stream is the JetStream stream of the messages. When set, the messages
+	// are consumed by a durable consumer, and acknowledged once their
+	// Triggers were processed. Otherwise, the messages are received by a
+	// queue subscription, and are lost if their processing fails.
+	// +optional
+	Stream string `json:"stream,omitempty"`
+	// Consumer is the name of the durable consumer of the Stream. Defaults
+	// to the name of the EventListener followed by the name of the source.
+	// +optional
+	Consumer string `json:"consumer,omitempty"`
+	// SecretName is the name of a Secret, in the namespace of the
+	// EventListener, holding the credentials of the NATS server: either a
+	// token key, or the username and password keys of a
+	// kubernetes.io/basic-auth Secret.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
 type Resources struct {
@@ -107,6 +531,34 @@ type EventListenerTrigger struct {
 	// multi-tenant model based scenarios
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Match optionally restricts the Trigger to requests with a specific
+	// URL path and HTTP method. When set alongside TriggerRef, it takes
+	// precedence over the match of the referenced Trigger.
+	// +optional
+	Match *RequestMatch `json:"match,omitempty"`
+	// RetryPolicy optionally retries the creation of resources that failed.
+	// When set alongside TriggerRef, it takes precedence over the retry
+	// policy of the referenced Trigger.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Concurrency optionally limits the PipelineRuns and TaskRuns created by
+	// the Trigger that run together for the same concurrency group. When set
+	// alongside TriggerRef, it takes precedence over the concurrency of the
+	// referenced Trigger.
+	// +optional
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	// Debounce optionally coalesces the events with the same key received
+	// within a window, so that the Trigger only processes the latest one.
+	// When set alongside TriggerRef, it takes precedence over the debounce of
+	// the referenced Trigger.
+	// +optional
+	Debounce *Debounce `json:"debounce,omitempty"`
+	// Schedule optionally makes the Trigger process events generated on a
+	// cron schedule instead of the events received by the EventListener.
+	// When set alongside TriggerRef, it takes precedence over the schedule of
+	// the referenced Trigger.
+	// +optional
+	Schedule *Schedule `json:"schedule,omitempty"`
 }
 
 // EventListenerTriggerGroup defines a group of Triggers that share a common set of interceptors
@@ -115,6 +567,10 @@ type EventListenerTriggerGroup struct {
 	// +listType=atomic
 	Interceptors    []*TriggerInterceptor        `json:"interceptors"`
 	TriggerSelector EventListenerTriggerSelector `json:"triggerSelector"`
+	// Match optionally restricts the TriggerGroup to requests with a specific
+	// URL path and HTTP method
+	// +optional
+	Match *RequestMatch `json:"match,omitempty"`
 }
 
 // EventListenerTriggerSelector  defines ways to select a group of triggers using their metadata
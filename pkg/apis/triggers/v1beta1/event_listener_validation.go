@@ -21,10 +21,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
 
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	"golang.org/x/net/http/httpguts"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
@@ -91,6 +98,276 @@ func (s *EventListenerSpec) validate(ctx context.Context) (errs *apis.FieldError
 		}
 	}
 
+	if s.Deduplication != nil {
+		errs = errs.Also(s.Deduplication.validate().ViaField("spec.deduplication"))
+	}
+
+	if s.Workers != nil {
+		errs = errs.Also(s.Workers.validate().ViaField("spec.workers"))
+	}
+
+	errs = errs.Also(s.RetryPolicy.validate(ctx).ViaField("spec.retryPolicy"))
+
+	if s.Replay != nil {
+		errs = errs.Also(s.Replay.validate().ViaField("spec.replay"))
+	}
+
+	if s.TriggerRuns != nil && s.TriggerRuns.TTL != nil && s.TriggerRuns.TTL.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.TriggerRuns.TTL.Duration.String(), "spec.triggerRuns.ttl", "ttl must be positive"))
+	}
+
+	if s.Response != nil {
+		errs = errs.Also(s.Response.validate().ViaField("spec.response"))
+	}
+
+	if s.MaxBodySize != nil && s.MaxBodySize.Value() <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.MaxBodySize.String(), "spec.maxBodySize", "maxBodySize must be positive"))
+	}
+
+	if s.ShutdownGracePeriod != nil && s.ShutdownGracePeriod.Duration < time.Second {
+		errs = errs.Also(apis.ErrInvalidValue(s.ShutdownGracePeriod.Duration.String(), "spec.shutdownGracePeriod", "shutdownGracePeriod must be at least 1s"))
+	}
+
+	if s.ShutdownDelay != nil && s.ShutdownDelay.Duration < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.ShutdownDelay.Duration.String(), "spec.shutdownDelay", "shutdownDelay must not be negative"))
+	}
+
+	if s.Debug != nil {
+		errs = errs.Also(s.Debug.validate().ViaField("spec.debug"))
+	}
+
+	if s.Authentication != nil {
+		errs = errs.Also(s.Authentication.validate().ViaField("spec.authentication"))
+	}
+
+	names := sets.New[string]()
+	for i, source := range s.Sources {
+		errs = errs.Also(source.validate().ViaField(fmt.Sprintf("spec.sources[%d]", i)))
+		if names.Has(source.Name) {
+			errs = errs.Also(apis.ErrInvalidValue(source.Name, fmt.Sprintf("spec.sources[%d].name", i), "name must be unique"))
+		}
+		names.Insert(source.Name)
+	}
+
+	return errs
+}
+
+func (s *EventSource) validate() (errs *apis.FieldError) {
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Label(s.Name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(s.Name, "name", strings.Join(msgs, ", ")))
+	}
+	var sources []string
+	if s.Git != nil {
+		sources = append(sources, "git")
+		errs = errs.Also(s.Git.validate().ViaField("git"))
+	}
+	if s.Watch != nil {
+		sources = append(sources, "watch")
+		errs = errs.Also(s.Watch.validate().ViaField("watch"))
+	}
+	if s.NATS != nil {
+		sources = append(sources, "nats")
+		errs = errs.Also(s.NATS.validate().ViaField("nats"))
+	}
+	switch len(sources) {
+	case 0:
+		errs = errs.Also(apis.ErrMissingOneOf("git", "watch", "nats"))
+	case 1:
+	default:
+		errs = errs.Also(apis.ErrMultipleOneOf(sources...))
+	}
+	return errs
+}
+
+func (g *GitSource) validate() (errs *apis.FieldError) {
+	if g.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if !isValidGitURL(g.URL) {
+		errs = errs.Also(apis.ErrInvalidValue(g.URL, "url", "url must be an http, https or ssh URL, e.g. https://github.com/tektoncd/triggers.git or git@github.com:tektoncd/triggers.git"))
+	}
+	for i, ref := range g.Refs {
+		if !strings.HasPrefix(ref, "refs/") {
+			errs = errs.Also(apis.ErrInvalidValue(ref, fmt.Sprintf("refs[%d]", i), "ref must start with refs/, e.g. refs/heads/main"))
+		} else if _, err := path.Match(ref, ""); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(ref, fmt.Sprintf("refs[%d]", i), err.Error()))
+		}
+	}
+	if g.Interval != nil && g.Interval.Duration < 10*time.Second {
+		errs = errs.Also(apis.ErrInvalidValue(g.Interval.Duration.String(), "interval", "interval must be at least 10s"))
+	}
+	return errs
+}
+
+// isValidGitURL returns whether the URL is an http, https or ssh URL, or uses
+// the scp-like syntax of SSH.
+func isValidGitURL(rawURL string) bool {
+	if !strings.Contains(rawURL, "://") {
+		host, p, ok := strings.Cut(rawURL, ":")
+		return ok && host != "" && p != "" && !strings.Contains(host, "/") && !strings.HasPrefix(host, "-")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https" || u.Scheme == "ssh"
+}
+
+func (w *WatchSource) validate() (errs *apis.FieldError) {
+	if w.Version == "" {
+		errs = errs.Also(apis.ErrMissingField("version"))
+	}
+	if w.Resource == "" {
+		errs = errs.Also(apis.ErrMissingField("resource"))
+	}
+	if w.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(w.LabelSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(w.LabelSelector, "labelSelector", err.Error()))
+		}
+	}
+	if w.FieldSelector != "" {
+		if _, err := fields.ParseSelector(w.FieldSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(w.FieldSelector, "fieldSelector", err.Error()))
+		}
+	}
+	for i, t := range w.EventTypes {
+		switch t {
+		case WatchEventAdd, WatchEventUpdate, WatchEventDelete:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(t, fmt.Sprintf("eventTypes[%d]", i), "event type must be one of add, update or delete"))
+		}
+	}
+	return errs
+}
+
+func (n *NATSSource) validate() (errs *apis.FieldError) {
+	if n.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	}
+	if n.Subject == "" {
+		errs = errs.Also(apis.ErrMissingField("subject"))
+	}
+	if n.Consumer != "" && n.Stream == "" {
+		errs = errs.Also(apis.ErrInvalidValue(n.Consumer, "consumer", "consumer requires a stream"))
+	}
+	if strings.ContainsAny(n.Consumer, ".*> ") {
+		errs = errs.Also(apis.ErrInvalidValue(n.Consumer, "consumer", "consumer must not contain '.', '*', '>' or spaces"))
+	}
+	return errs
+}
+
+func (r *Response) validate() (errs *apis.FieldError) {
+	switch r.Mode {
+	case "", ResponseModeAsync, ResponseModeSync:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(r.Mode, "mode", "mode must be async or sync"))
+	}
+	if r.Timeout != nil && r.Timeout.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(r.Timeout.Duration.String(), "timeout", "timeout must be positive"))
+	}
+	return errs
+}
+
+func (d *Deduplication) validate() (errs *apis.FieldError) {
+	switch {
+	case d.Key == "":
+		errs = errs.Also(apis.ErrMissingField("key"))
+	case !strings.Contains(d.Key, "$("):
+		errs = errs.Also(apis.ErrInvalidValue(d.Key, "key", "key must reference the event, e.g. $(header.X-Github-Delivery)"))
+	default:
+		errs = errs.Also(validateParamValue(d.Key).ViaField("key"))
+	}
+	if d.TTL != nil && d.TTL.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(d.TTL.Duration.String(), "ttl", "ttl must be positive"))
+	}
+	return errs
+}
+
+func (w *Workers) validate() (errs *apis.FieldError) {
+	if w.MaxConcurrency < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(w.MaxConcurrency, "maxConcurrency", "maxConcurrency must be at least 1"))
+	}
+	if w.QueueSize != nil && *w.QueueSize < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*w.QueueSize, "queueSize", "queueSize cannot be negative"))
+	}
+	if w.RetryAfter != nil && w.RetryAfter.Duration < time.Second {
+		errs = errs.Also(apis.ErrInvalidValue(w.RetryAfter.Duration.String(), "retryAfter", "retryAfter must be at least 1s"))
+	}
+	return errs
+}
+
+func (r *Replay) validate() (errs *apis.FieldError) {
+	if r.MaxEvents != nil && *r.MaxEvents < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*r.MaxEvents, "maxEvents", "maxEvents must be at least 1"))
+	}
+	for i, h := range r.RedactHeaders {
+		if !httpguts.ValidHeaderFieldName(h) {
+			errs = errs.Also(apis.ErrInvalidValue(h, fmt.Sprintf("redactHeaders[%d]", i), "must be a valid header name"))
+		}
+	}
+	if r.TokenRef.SecretName == "" {
+		errs = errs.Also(apis.ErrMissingField("tokenRef.secretName"))
+	}
+	if r.TokenRef.SecretKey == "" {
+		errs = errs.Also(apis.ErrMissingField("tokenRef.secretKey"))
+	}
+	return errs
+}
+
+func (d *Debug) validate() (errs *apis.FieldError) {
+	if d.MaxDecisions != nil && *d.MaxDecisions < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*d.MaxDecisions, "maxDecisions", "maxDecisions must be at least 1"))
+	}
+	if d.TokenRef.SecretName == "" {
+		errs = errs.Also(apis.ErrMissingField("tokenRef.secretName"))
+	}
+	if d.TokenRef.SecretKey == "" {
+		errs = errs.Also(apis.ErrMissingField("tokenRef.secretKey"))
+	}
+	return errs
+}
+
+func (a *Authentication) validate() (errs *apis.FieldError) {
+	if len(a.TokenRefs) == 0 && a.ClientCARef == nil && a.TokenReview == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("tokenRefs", "clientCARef", "tokenReview"))
+	}
+	for i, ref := range a.TokenRefs {
+		errs = errs.Also(ref.validate().ViaFieldIndex("tokenRefs", i))
+	}
+	if a.APIKeyHeader != "" {
+		if !httpguts.ValidHeaderFieldName(a.APIKeyHeader) {
+			errs = errs.Also(apis.ErrInvalidValue(a.APIKeyHeader, "apiKeyHeader", "must be a valid header name"))
+		}
+		if len(a.TokenRefs) == 0 {
+			errs = errs.Also(apis.ErrGeneric("apiKeyHeader requires tokenRefs", "apiKeyHeader"))
+		}
+	}
+	if a.ClientCARef != nil {
+		errs = errs.Also(a.ClientCARef.validate().ViaField("clientCARef"))
+	}
+	if a.TokenReview != nil {
+		for i, u := range a.TokenReview.Users {
+			if u == "" || strings.Contains(u, ",") {
+				errs = errs.Also(apis.ErrInvalidValue(u, fmt.Sprintf("tokenReview.users[%d]", i), "must be a non-empty user name without commas"))
+			}
+		}
+		for i, aud := range a.TokenReview.Audiences {
+			if aud == "" || strings.Contains(aud, ",") {
+				errs = errs.Also(apis.ErrInvalidValue(aud, fmt.Sprintf("tokenReview.audiences[%d]", i), "must be a non-empty audience without commas"))
+			}
+		}
+	}
+	return errs
+}
+
+func (s SecretRef) validate() (errs *apis.FieldError) {
+	if s.SecretName == "" {
+		errs = errs.Also(apis.ErrMissingField("secretName"))
+	}
+	if s.SecretKey == "" {
+		errs = errs.Also(apis.ErrMissingField("secretKey"))
+	}
 	return errs
 }
 
@@ -101,6 +378,7 @@ func (g *EventListenerTriggerGroup) validate(ctx context.Context) (errs *apis.Fi
 	if len(g.Interceptors) == 0 {
 		errs = errs.Also(apis.ErrMissingField("interceptors"))
 	}
+	errs = errs.Also(g.Match.validate(ctx).ViaField("match"))
 	return errs
 }
 
@@ -335,6 +613,21 @@ func (t *EventListenerTrigger) validate(ctx context.Context) (errs *apis.FieldEr
 		errs = errs.Also(interceptor.validate(ctx).ViaField(fmt.Sprintf("interceptors[%d]", i)))
 	}
 
+	// Validate optional Match
+	errs = errs.Also(t.Match.validate(ctx).ViaField("match"))
+
+	// Validate optional RetryPolicy
+	errs = errs.Also(t.RetryPolicy.validate(ctx).ViaField("retryPolicy"))
+
+	// Validate optional Concurrency
+	errs = errs.Also(t.Concurrency.validate().ViaField("concurrency"))
+
+	// Validate optional Debounce
+	errs = errs.Also(t.Debounce.validate().ViaField("debounce"))
+
+	// Validate optional Schedule
+	errs = errs.Also(t.Schedule.validate().ViaField("schedule"))
+
 	// The trigger name is added as a label value for 'tekton.dev/trigger' so it must follow the k8s label guidelines:
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#syntax-and-character-set
 	if err := validation.IsValidLabelValue(t.Name); len(err) > 0 {
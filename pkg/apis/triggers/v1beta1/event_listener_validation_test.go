@@ -18,7 +18,9 @@ package v1beta1_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
@@ -90,6 +92,196 @@ func Test_EventListenerValidate(t *testing.T) {
 				}},
 			},
 		},
+	}, {
+		name: "Valid EventListener with Trigger and TriggerGroup match",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+					Match: &triggersv1beta1.RequestMatch{
+						Path:    "/github",
+						Methods: []string{http.MethodPost},
+					},
+				}},
+				TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{{
+					Name: "gitlab",
+					Interceptors: []*triggersv1beta1.TriggerInterceptor{{
+						Ref: triggersv1beta1.InterceptorRef{Name: "gitlab"},
+					}},
+					TriggerSelector: triggersv1beta1.EventListenerTriggerSelector{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"provider": "gitlab"},
+						},
+					},
+					Match: &triggersv1beta1.RequestMatch{Path: "/gitlab/"},
+				}},
+			},
+		},
+	}, {
+		name: "Valid EventListener with deduplication",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Deduplication: &triggersv1beta1.Deduplication{
+					Key: "$(header.X-Github-Delivery)",
+					TTL: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with workers",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Workers: &triggersv1beta1.Workers{
+					MaxConcurrency: 10,
+					QueueSize:      ptr.Int32(0),
+					RetryAfter:     &metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with retry policies",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}, {
+					Name:     "with-retry-policy",
+					Bindings: []*triggersv1beta1.EventListenerBinding{{Ref: "tb", Kind: triggersv1beta1.NamespacedTriggerBindingKind}},
+					Template: &triggersv1beta1.EventListenerTemplate{Ref: ptr.String("tt2")},
+					RetryPolicy: &triggersv1beta1.RetryPolicy{
+						RetryOn:    []triggersv1beta1.RetryCondition{triggersv1beta1.RetryOnConflict},
+						DeadLetter: &triggersv1beta1.DeadLetter{ConfigMap: &triggersv1beta1.DeadLetterConfigMap{GenerateName: "failed-"}},
+					},
+				}},
+				RetryPolicy: &triggersv1beta1.RetryPolicy{
+					MaxAttempts: ptr.Int32(5),
+					Backoff:     &metav1.Duration{Duration: 2 * time.Second},
+					DeadLetter:  &triggersv1beta1.DeadLetter{URI: "http://dead-letter.default.svc.cluster.local"},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with replay",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Replay: &triggersv1beta1.Replay{
+					MaxEvents:     ptr.Int32(20),
+					RedactHeaders: []string{"X-Api-Key"},
+					TokenRef:      triggersv1beta1.SecretRef{SecretName: "replay", SecretKey: "token"},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with debug",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Debug: &triggersv1beta1.Debug{
+					MaxDecisions: ptr.Int32(5),
+					TokenRef:     triggersv1beta1.SecretRef{SecretName: "debug", SecretKey: "token"},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with authentication",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Authentication: &triggersv1beta1.Authentication{
+					TokenRefs:    []triggersv1beta1.SecretRef{{SecretName: "api-keys", SecretKey: "ci"}},
+					APIKeyHeader: "X-Api-Key",
+					ClientCARef:  &triggersv1beta1.SecretRef{SecretName: "client-ca", SecretKey: "ca.crt"},
+					TokenReview: &triggersv1beta1.TokenReview{
+						Audiences: []string{"eventlistener"},
+						Users:     []string{"system:serviceaccount:ci:builder"},
+					},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with response",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Response: &triggersv1beta1.Response{
+					Mode:    triggersv1beta1.ResponseModeSync,
+					Timeout: &metav1.Duration{Duration: 3 * time.Second},
+				},
+			},
+		},
+	}, {
+		name: "Valid EventListener with triggerRuns",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				TriggerRuns: &triggersv1beta1.TriggerRuns{TTL: &metav1.Duration{Duration: time.Hour}},
+			},
+		},
+	}, {
+		name: "Valid EventListener with git source",
+		el: &triggersv1beta1.EventListener{
+			ObjectMeta: myObjectMeta,
+			Spec: triggersv1beta1.EventListenerSpec{
+				Triggers: []triggersv1beta1.EventListenerTrigger{{
+					TriggerRef: "tt",
+				}},
+				Sources: []triggersv1beta1.EventSource{{
+					Name: "internal-git",
+					Git: &triggersv1beta1.GitSource{
+						URL:        "ssh://git@git.example.com/team/app.git",
+						Refs:       []string{"refs/heads/main", "refs/tags/v*"},
+						Interval:   &metav1.Duration{Duration: 5 * time.Minute},
+						SecretName: "git-credentials",
+					},
+				}, {
+					Name: "pipelineruns",
+					Watch: &triggersv1beta1.WatchSource{
+						Group:         "tekton.dev",
+						Version:       "v1",
+						Resource:      "pipelineruns",
+						Namespace:     "builds",
+						LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "build"}},
+						FieldSelector: "metadata.name!=ignored",
+						EventTypes:    []triggersv1beta1.WatchEventType{triggersv1beta1.WatchEventUpdate},
+					},
+				}, {
+					Name: "nats",
+					NATS: &triggersv1beta1.NATSSource{
+						URL:        "nats://nats.nats-system:4222",
+						Subject:    "builds.>",
+						Stream:     "BUILDS",
+						Consumer:   "triggers",
+						SecretName: "nats-credentials",
+					},
+				}},
+			},
+		},
 	}, {
 		name: "Valid EventListener with Annotation",
 		el: &triggersv1beta1.EventListener{
@@ -1415,6 +1607,406 @@ func TestEventListenerValidate_error(t *testing.T) {
 				},
 			},
 			wantErr: apis.ErrMissingOneOf("spec.labelSelector", "spec.namespaceSelector", "spec.triggerGroups", "spec.triggers"),
+		}, {
+			name: "trigger match with relative path",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Match:      &triggersv1beta1.RequestMatch{Path: "github"},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`path "github" must start with '/'`, "spec.triggers[0].match.path"),
+		}, {
+			name: "trigger match with reserved path",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Match:      &triggersv1beta1.RequestMatch{Path: "/live/"},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`path "/live/" is reserved by the EventListener`, "spec.triggers[0].match.path"),
+		}, {
+			name: "trigger match with admin path",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Match:      &triggersv1beta1.RequestMatch{Path: "/admin/events"},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`path "/admin/events" is reserved by the EventListener`, "spec.triggers[0].match.path"),
+		}, {
+			name: "trigger match with debug path",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Match:      &triggersv1beta1.RequestMatch{Path: "/debug/triggers"},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`path "/debug/triggers" is reserved by the EventListener`, "spec.triggers[0].match.path"),
+		}, {
+			name: "replay with invalid values",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Replay: &triggersv1beta1.Replay{
+						MaxEvents:     ptr.Int32(0),
+						RedactHeaders: []string{"X-Api Key"},
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(0, "spec.replay.maxEvents", "maxEvents must be at least 1").Also(
+				apis.ErrInvalidValue("X-Api Key", "spec.replay.redactHeaders[0]", "must be a valid header name")).Also(
+				apis.ErrMissingField("spec.replay.tokenRef.secretName")).Also(
+				apis.ErrMissingField("spec.replay.tokenRef.secretKey")),
+		}, {
+			name: "debug with invalid values",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Debug: &triggersv1beta1.Debug{
+						MaxDecisions: ptr.Int32(0),
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(0, "spec.debug.maxDecisions", "maxDecisions must be at least 1").Also(
+				apis.ErrMissingField("spec.debug.tokenRef.secretName")).Also(
+				apis.ErrMissingField("spec.debug.tokenRef.secretKey")),
+		}, {
+			name: "authentication without methods",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:       []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Authentication: &triggersv1beta1.Authentication{APIKeyHeader: "X-Api Key"},
+				},
+			},
+			wantErr: apis.ErrMissingOneOf("spec.authentication.tokenRefs", "spec.authentication.clientCARef", "spec.authentication.tokenReview").Also(
+				apis.ErrInvalidValue("X-Api Key", "spec.authentication.apiKeyHeader", "must be a valid header name")).Also(
+				apis.ErrGeneric("apiKeyHeader requires tokenRefs", "spec.authentication.apiKeyHeader")),
+		}, {
+			name: "authentication with invalid values",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Authentication: &triggersv1beta1.Authentication{
+						TokenRefs:   []triggersv1beta1.SecretRef{{SecretName: "api-keys"}},
+						ClientCARef: &triggersv1beta1.SecretRef{SecretKey: "ca.crt"},
+						TokenReview: &triggersv1beta1.TokenReview{
+							Audiences: []string{"a,b"},
+							Users:     []string{""},
+						},
+					},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.authentication.tokenRefs[0].secretKey").Also(
+				apis.ErrMissingField("spec.authentication.clientCARef.secretName")).Also(
+				apis.ErrInvalidValue("", "spec.authentication.tokenReview.users[0]", "must be a non-empty user name without commas")).Also(
+				apis.ErrInvalidValue("a,b", "spec.authentication.tokenReview.audiences[0]", "must be a non-empty audience without commas")),
+		}, {
+			name: "triggerRuns with negative ttl",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:    []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					TriggerRuns: &triggersv1beta1.TriggerRuns{TTL: &metav1.Duration{Duration: -time.Minute}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("-1m0s", "spec.triggerRuns.ttl", "ttl must be positive"),
+		}, {
+			name: "negative maxBodySize",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:    []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					MaxBodySize: resource.NewQuantity(-1, resource.BinarySI),
+				},
+			},
+			wantErr: apis.ErrInvalidValue("-1", "spec.maxBodySize", "maxBodySize must be positive"),
+		}, {
+			name: "shutdownGracePeriod under 1s",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:            []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					ShutdownGracePeriod: &metav1.Duration{Duration: 500 * time.Millisecond},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("500ms", "spec.shutdownGracePeriod", "shutdownGracePeriod must be at least 1s"),
+		}, {
+			name: "invalid response",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Response: &triggersv1beta1.Response{
+						Mode:    "later",
+						Timeout: &metav1.Duration{},
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("later", "spec.response.mode", "mode must be async or sync").
+				Also(apis.ErrInvalidValue("0s", "spec.response.timeout", "timeout must be positive")),
+		}, {
+			name: "negative shutdownDelay",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:      []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					ShutdownDelay: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("-1s", "spec.shutdownDelay", "shutdownDelay must not be negative"),
+		}, {
+			name: "invalid git sources",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Sources: []triggersv1beta1.EventSource{{
+						Name: "git",
+						Git: &triggersv1beta1.GitSource{
+							Refs:     []string{"main", "refs/heads/[release"},
+							Interval: &metav1.Duration{Duration: time.Second},
+						},
+					}, {
+						Name: "git",
+					}, {
+						Name: "local-git",
+						Git:  &triggersv1beta1.GitSource{URL: "file:///srv/app.git"},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.sources[0].git.url").Also(
+				apis.ErrInvalidValue("main", "spec.sources[0].git.refs[0]", "ref must start with refs/, e.g. refs/heads/main")).Also(
+				apis.ErrInvalidValue("refs/heads/[release", "spec.sources[0].git.refs[1]", "syntax error in pattern")).Also(
+				apis.ErrInvalidValue("1s", "spec.sources[0].git.interval", "interval must be at least 10s")).Also(
+				apis.ErrMissingOneOf("spec.sources[1].git", "spec.sources[1].watch", "spec.sources[1].nats")).Also(
+				apis.ErrInvalidValue("git", "spec.sources[1].name", "name must be unique")).Also(
+				apis.ErrInvalidValue("file:///srv/app.git", "spec.sources[2].git.url", "url must be an http, https or ssh URL, e.g. https://github.com/tektoncd/triggers.git or git@github.com:tektoncd/triggers.git")),
+		}, {
+			name: "invalid watch sources",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Sources: []triggersv1beta1.EventSource{{
+						Name: "watch",
+						Watch: &triggersv1beta1.WatchSource{
+							FieldSelector: "metadata.name",
+							EventTypes:    []triggersv1beta1.WatchEventType{"modify"},
+						},
+					}, {
+						Name:  "both",
+						Git:   &triggersv1beta1.GitSource{URL: "https://example.com/repo.git"},
+						Watch: &triggersv1beta1.WatchSource{Version: "v1", Resource: "configmaps"},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.sources[0].watch.version").Also(
+				apis.ErrMissingField("spec.sources[0].watch.resource")).Also(
+				apis.ErrInvalidValue("metadata.name", "spec.sources[0].watch.fieldSelector", "invalid selector: 'metadata.name'; can't understand 'metadata.name'")).Also(
+				apis.ErrInvalidValue("modify", "spec.sources[0].watch.eventTypes[0]", "event type must be one of add, update or delete")).Also(
+				apis.ErrMultipleOneOf("spec.sources[1].git", "spec.sources[1].watch")),
+		}, {
+			name: "invalid nats source",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Sources: []triggersv1beta1.EventSource{{
+						Name: "nats",
+						NATS: &triggersv1beta1.NATSSource{Consumer: "builds.triggers"},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.sources[0].nats.url").Also(
+				apis.ErrMissingField("spec.sources[0].nats.subject")).Also(
+				apis.ErrInvalidValue("builds.triggers", "spec.sources[0].nats.consumer", "consumer requires a stream")).Also(
+				apis.ErrInvalidValue("builds.triggers", "spec.sources[0].nats.consumer", "consumer must not contain '.', '*', '>' or spaces")),
+		}, {
+			name: "trigger group match with unsupported method",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{{
+						Name: "group",
+						Interceptors: []*triggersv1beta1.TriggerInterceptor{{
+							Ref: triggersv1beta1.InterceptorRef{Name: "cel"},
+						}},
+						TriggerSelector: triggersv1beta1.EventListenerTriggerSelector{
+							NamespaceSelector: triggersv1beta1.NamespaceSelector{MatchNames: []string{"foo"}},
+						},
+						Match: &triggersv1beta1.RequestMatch{Methods: []string{"post"}},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`unsupported HTTP method "post"`, "spec.triggerGroups[0].match.methods[0]"),
+		}, {
+			name: "deduplication without key",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:      []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Deduplication: &triggersv1beta1.Deduplication{},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.deduplication.key"),
+		}, {
+			name: "deduplication key without expression",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:      []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Deduplication: &triggersv1beta1.Deduplication{Key: "X-Github-Delivery"},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("X-Github-Delivery", "spec.deduplication.key", "key must reference the event, e.g. $(header.X-Github-Delivery)"),
+		}, {
+			name: "deduplication with negative ttl",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Deduplication: &triggersv1beta1.Deduplication{
+						Key: "$(body.id)",
+						TTL: &metav1.Duration{Duration: -time.Minute},
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("-1m0s", "spec.deduplication.ttl", "ttl must be positive"),
+		}, {
+			name: "workers without maxConcurrency",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Workers:  &triggersv1beta1.Workers{},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(0, "spec.workers.maxConcurrency", "maxConcurrency must be at least 1"),
+		}, {
+			name: "workers with negative queueSize and short retryAfter",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					Workers: &triggersv1beta1.Workers{
+						MaxConcurrency: 1,
+						QueueSize:      ptr.Int32(-1),
+						RetryAfter:     &metav1.Duration{Duration: 500 * time.Millisecond},
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(-1, "spec.workers.queueSize", "queueSize cannot be negative").Also(
+				apis.ErrInvalidValue("500ms", "spec.workers.retryAfter", "retryAfter must be at least 1s")),
+		}, {
+			name: "retry policy with invalid values",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					RetryPolicy: &triggersv1beta1.RetryPolicy{
+						MaxAttempts: ptr.Int32(0),
+						Backoff:     &metav1.Duration{},
+						RetryOn:     []triggersv1beta1.RetryCondition{"Forbidden"},
+					},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(0, "spec.retryPolicy.maxAttempts", "maxAttempts must be at least 1").Also(
+				apis.ErrInvalidValue("0s", "spec.retryPolicy.backoff", "backoff must be positive")).Also(
+				apis.ErrInvalidValue(`unsupported retry condition "Forbidden"`, "spec.retryPolicy.retryOn[0]")),
+		}, {
+			name: "dead letter without destination",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef:  "tt",
+						RetryPolicy: &triggersv1beta1.RetryPolicy{DeadLetter: &triggersv1beta1.DeadLetter{}},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingOneOf("spec.triggers[0].retryPolicy.deadLetter.uri", "spec.triggers[0].retryPolicy.deadLetter.configMap"),
+		}, {
+			name: "dead letter with both destinations",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					RetryPolicy: &triggersv1beta1.RetryPolicy{DeadLetter: &triggersv1beta1.DeadLetter{
+						URI:       "http://dead-letter",
+						ConfigMap: &triggersv1beta1.DeadLetterConfigMap{},
+					}},
+				},
+			},
+			wantErr: apis.ErrMultipleOneOf("spec.retryPolicy.deadLetter.uri", "spec.retryPolicy.deadLetter.configMap"),
+		}, {
+			name: "dead letter with relative uri",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers:    []triggersv1beta1.EventListenerTrigger{{TriggerRef: "tt"}},
+					RetryPolicy: &triggersv1beta1.RetryPolicy{DeadLetter: &triggersv1beta1.DeadLetter{URI: "dead-letter"}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue(`uri "dead-letter" must be an absolute URL`, "spec.retryPolicy.deadLetter.uri"),
+		}, {
+			name: "concurrency with invalid key and policy",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Concurrency: &triggersv1beta1.Concurrency{
+							Key:    "$(body.repository $(body.pull_request.number)",
+							Policy: "cancel",
+						},
+					}},
+				},
+			},
+			wantErr: apis.ErrInvalidValue("$(body.repository $(body.pull_request.number)", "spec.triggers[0].concurrency.key.value").Also(
+				apis.ErrInvalidValue("cancel", "spec.triggers[0].concurrency.policy", "policy must be one of cancel-in-progress, queue or skip-if-running")),
+		}, {
+			name: "debounce without key and with negative window",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Debounce:   &triggersv1beta1.Debounce{Window: metav1.Duration{Duration: -time.Second}},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.triggers[0].debounce.key").Also(
+				apis.ErrInvalidValue("-1s", "spec.triggers[0].debounce.window", "window must be positive")),
+		}, {
+			name: "schedule without cron and with invalid time zone",
+			el: &triggersv1beta1.EventListener{
+				ObjectMeta: myObjectMeta,
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "tt",
+						Schedule:   &triggersv1beta1.Schedule{TimeZone: "Mars/Olympus_Mons"},
+					}},
+				},
+			},
+			wantErr: apis.ErrMissingField("spec.triggers[0].schedule.cron").Also(
+				apis.ErrInvalidValue("Mars/Olympus_Mons", "spec.triggers[0].schedule.timeZone", "timeZone must be an IANA time zone, e.g. Europe/Paris")),
 		}, {
 			name: "invalid interceptor for eventlistener",
 			el: &triggersv1beta1.EventListener{
@@ -26,6 +26,11 @@ type InterceptorRequest struct {
 	// string which means that we will lose the spaces any time we marshal this struct.
 	Body string `json:"body,omitempty"`
 
+	// RawBody is the body as it was received when the EventListener decompressed it, i.e.
+	// when the incoming HTTP event had a gzip or deflate Content-Encoding. Interceptors use it
+	// to validate signatures computed over the compressed body.
+	RawBody []byte `json:"raw_body,omitempty"`
+
 	// Header are the headers for the incoming HTTP event
 	Header map[string][]string `json:"header,omitempty"`
 
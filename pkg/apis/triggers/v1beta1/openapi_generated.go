@@ -28,9 +28,16 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Authentication":               schema_pkg_apis_triggers_v1beta1_Authentication(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBinding":        schema_pkg_apis_triggers_v1beta1_ClusterTriggerBinding(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBindingList":    schema_pkg_apis_triggers_v1beta1_ClusterTriggerBindingList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Concurrency":                  schema_pkg_apis_triggers_v1beta1_Concurrency(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource":               schema_pkg_apis_triggers_v1beta1_CustomResource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetter":                   schema_pkg_apis_triggers_v1beta1_DeadLetter(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetterConfigMap":          schema_pkg_apis_triggers_v1beta1_DeadLetterConfigMap(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debounce":                     schema_pkg_apis_triggers_v1beta1_Debounce(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debug":                        schema_pkg_apis_triggers_v1beta1_Debug(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Deduplication":                schema_pkg_apis_triggers_v1beta1_Deduplication(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListener":                schema_pkg_apis_triggers_v1beta1_EventListener(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig":          schema_pkg_apis_triggers_v1beta1_EventListenerConfig(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerList":            schema_pkg_apis_triggers_v1beta1_EventListenerList(ref),
@@ -39,18 +46,28 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger":         schema_pkg_apis_triggers_v1beta1_EventListenerTrigger(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup":    schema_pkg_apis_triggers_v1beta1_EventListenerTriggerGroup(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector": schema_pkg_apis_triggers_v1beta1_EventListenerTriggerSelector(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventSource":                  schema_pkg_apis_triggers_v1beta1_EventSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.GitSource":                    schema_pkg_apis_triggers_v1beta1_GitSource(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorParams":            schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRef":               schema_pkg_apis_triggers_v1beta1_InterceptorRef(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRequest":           schema_pkg_apis_triggers_v1beta1_InterceptorRequest(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorResponse":          schema_pkg_apis_triggers_v1beta1_InterceptorResponse(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource":           schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource":                   schema_pkg_apis_triggers_v1beta1_NATSSource(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector":            schema_pkg_apis_triggers_v1beta1_NamespaceSelector(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param":                        schema_pkg_apis_triggers_v1beta1_Param(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ParamSpec":                    schema_pkg_apis_triggers_v1beta1_ParamSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Replay":                       schema_pkg_apis_triggers_v1beta1_Replay(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch":                 schema_pkg_apis_triggers_v1beta1_RequestMatch(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources":                    schema_pkg_apis_triggers_v1beta1_Resources(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Response":                     schema_pkg_apis_triggers_v1beta1_Response(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy":                  schema_pkg_apis_triggers_v1beta1_RetryPolicy(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Schedule":                     schema_pkg_apis_triggers_v1beta1_Schedule(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef":                    schema_pkg_apis_triggers_v1beta1_SecretRef(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Status":                       schema_pkg_apis_triggers_v1beta1_Status(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.StatusError":                  schema_pkg_apis_triggers_v1beta1_StatusError(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TokenReview":                  schema_pkg_apis_triggers_v1beta1_TokenReview(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Transaction":                  schema_pkg_apis_triggers_v1beta1_Transaction(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Trigger":                      schema_pkg_apis_triggers_v1beta1_Trigger(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBinding":               schema_pkg_apis_triggers_v1beta1_TriggerBinding(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingList":           schema_pkg_apis_triggers_v1beta1_TriggerBindingList(ref),
@@ -60,6 +77,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor":           schema_pkg_apis_triggers_v1beta1_TriggerInterceptor(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerList":                  schema_pkg_apis_triggers_v1beta1_TriggerList(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerResourceTemplate":      schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRuns":                  schema_pkg_apis_triggers_v1beta1_TriggerRuns(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec":                  schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding":           schema_pkg_apis_triggers_v1beta1_TriggerSpecBinding(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate":          schema_pkg_apis_triggers_v1beta1_TriggerSpecTemplate(ref),
@@ -67,7 +85,62 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateList":          schema_pkg_apis_triggers_v1beta1_TriggerTemplateList(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateSpec":          schema_pkg_apis_triggers_v1beta1_TriggerTemplateSpec(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateStatus":        schema_pkg_apis_triggers_v1beta1_TriggerTemplateStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WatchSource":                  schema_pkg_apis_triggers_v1beta1_WatchSource(ref),
 		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WebhookInterceptor":           schema_pkg_apis_triggers_v1beta1_WebhookInterceptor(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Workers":                      schema_pkg_apis_triggers_v1beta1_Workers(ref),
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Authentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Authentication configures how an EventListener authenticates the requests it receives. A request is accepted when any of the configured methods authenticates it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokenRefs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRefs refer to the keys of Secrets, in the namespace of the EventListener, holding the bearer tokens or API keys accepted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"),
+									},
+								},
+							},
+						},
+					},
+					"apiKeyHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKeyHeader is the header holding an API key, e.g. X-Api-Key. The tokens are also accepted as bearer tokens in the Authorization header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientCARef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientCARef refers to the key of a Secret, in the namespace of the EventListener, holding the PEM encoded certificates of the CAs that verify client certificates. The EventListener must serve HTTPS.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"),
+						},
+					},
+					"tokenReview": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenReview authenticates Kubernetes bearer tokens, such as the tokens of ServiceAccounts, with the TokenReview API.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TokenReview"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TokenReview"},
 	}
 }
 
@@ -168,6 +241,50 @@ func schema_pkg_apis_triggers_v1beta1_ClusterTriggerBindingList(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_Concurrency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Concurrency groups the PipelineRuns and TaskRuns created by a Trigger so that only the ones of a single event run at a time in each group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key is the concurrency group of the event. It can reference the event after the interceptors ran, e.g. $(body.pull_request.number).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is what happens when resources of the same group are still running. Defaults to queue.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxQueued": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxQueued is the maximum number of events waiting for their turn in each group. Newer events fail when it is reached. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queueTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueTimeout is how long an event waits for its turn in its group before it fails. Defaults to 1h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_CustomResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -178,6 +295,144 @@ func schema_pkg_apis_triggers_v1beta1_CustomResource(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_DeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetter is the destination of resources that could not be created. Exactly one of URI and ConfigMap must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URI is the address of a CloudEvents sink to send the dead letters to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMap stores each dead letter in a ConfigMap in the namespace of the Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetterConfigMap"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetterConfigMap"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_DeadLetterConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeadLetterConfigMap configures the ConfigMaps storing dead letters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"generateName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GenerateName is the prefix of the name of the ConfigMaps. Defaults to the name of the Trigger followed by \"-dead-letter-\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Debounce(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Debounce coalesces bursts of events for a Trigger. An event is processed once no newer event with the same key was received during the window. Events are held by the EventListener replica that received them, so the events of a burst are only coalesced when the same replica receives them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key identifies the events coalesced together. It can reference the event after the interceptors ran, e.g. $(body.ref).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Window is how long the Trigger waits for a newer event with the same key before processing an event.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"key", "window"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Debug(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Debug configures the debug endpoints of an EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxDecisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDecisions is the number of most recent decisions kept for each Trigger. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"tokenRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRef refers to the key of a Secret, in the namespace of the EventListener, holding the bearer token of the debug endpoints.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"),
+						},
+					},
+				},
+				Required: []string{"tokenRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Deduplication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Deduplication configures how an EventListener identifies repeated deliveries of the same event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Key identifies a delivery. It uses the same syntax as binding values, e.g. $(header.X-Github-Delivery) or $(body.event_id).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is how long a delivery is remembered. Defaults to 1h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"key"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_EventListener(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -370,11 +625,96 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerSpec(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"deduplication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Deduplication optionally skips repeated deliveries of the same event, such as webhooks retried by the sender",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Deduplication"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers optionally bounds the number of Triggers and TriggerGroups processed concurrently by each EventListener replica",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Workers"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy optionally retries the creation of resources that failed for the Triggers that do not specify a retry policy",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy"),
+						},
+					},
+					"replay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replay optionally keeps the most recent events received by the EventListener so that they can be listed and replayed.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Replay"),
+						},
+					},
+					"triggerRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerRuns optionally records a TriggerRun for each event processed by each Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRuns"),
+						},
+					},
+					"sources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources optionally generate events processed by the Triggers of the EventListener, in addition to the events it receives.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventSource"),
+									},
+								},
+							},
+						},
+					},
+					"maxBodySize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBodySize optionally limits the size of the bodies of the requests received by the EventListener, before and after their decompression. Larger requests are rejected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"shutdownGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownGracePeriod is how long a terminating EventListener replica waits for the events it accepted to be processed. Defaults to 25s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"shutdownDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShutdownDelay is how long a terminating EventListener replica keeps accepting requests, while failing its readiness probe, so that it is removed from the endpoints of its Service before it stops accepting them. It should be longer than the period of the readiness probe. Defaults to 15s. It is ignored by EventListeners with a CustomResource, which do not delay their shutdown.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"debug": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Debug optionally exposes the debug endpoints of the EventListener, which describe the Triggers it resolves and the decisions they made.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debug"),
+						},
+					},
+					"authentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Authentication optionally authenticates the requests received by the EventListener before they are processed by its Triggers.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Authentication"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Response optionally configures how the EventListener responds to the requests that do not select a response mode.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Response"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Authentication", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debug", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Deduplication", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Replay", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Response", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRuns", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Workers", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -529,11 +869,41 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTrigger(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Match optionally restricts the Trigger to requests with a specific URL path and HTTP method. When set alongside TriggerRef, it takes precedence over the match of the referenced Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy optionally retries the creation of resources that failed. When set alongside TriggerRef, it takes precedence over the retry policy of the referenced Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency optionally limits the PipelineRuns and TaskRuns created by the Trigger that run together for the same concurrency group. When set alongside TriggerRef, it takes precedence over the concurrency of the referenced Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Concurrency"),
+						},
+					},
+					"debounce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Debounce optionally coalesces the events with the same key received within a window, so that the Trigger only processes the latest one. When set alongside TriggerRef, it takes precedence over the debounce of the referenced Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debounce"),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally makes the Trigger process events generated on a cron schedule instead of the events received by the EventListener. When set alongside TriggerRef, it takes precedence over the schedule of the referenced Trigger.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Schedule"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Concurrency", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debounce", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Schedule", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
 	}
 }
 
@@ -574,12 +944,18 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTriggerGroup(ref common.Refer
 							Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector"),
 						},
 					},
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Match optionally restricts the TriggerGroup to requests with a specific URL path and HTTP method",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch"),
+						},
+					},
 				},
 				Required: []string{"name", "interceptors", "triggerSelector"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor"},
 	}
 }
 
@@ -609,6 +985,105 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTriggerSelector(ref common.Re
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_EventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventSource generates events processed by the Triggers of an EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the source. Its events are sent to the /sources/<name> path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"git": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git polls the refs of a Git repository.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.GitSource"),
+						},
+					},
+					"watch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Watch watches the changes of Kubernetes resources.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WatchSource"),
+						},
+					},
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NATS subscribes to the messages of a NATS server.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.GitSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WatchSource"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_GitSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GitSource polls the refs of a Git repository over HTTP(S) or SSH, and generates a push event when one of the watched refs is created, updated or deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the repository, either an http, https or ssh URL, or the scp-like syntax of SSH, e.g. git@github.com:tektoncd/triggers.git.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"refs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Refs are the patterns of the watched refs, e.g. refs/heads/main or refs/tags/v*. Defaults to all the branches.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between two polls of the repository. Defaults to 1m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a Secret, in the namespace of the EventListener, holding the credentials of the repository: either the username and password keys of a kubernetes.io/basic-auth Secret, or the ssh-privatekey key of a kubernetes.io/ssh-auth Secret, with the known_hosts key verifying the key of the server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -843,6 +1318,57 @@ func schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_NATSSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSSource subscribes to the messages of a NATS server, and generates an event for each of them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the NATS server, e.g. nats://nats.nats-system:4222.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of the messages, which may contain wildcards, e.g. builds.>.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the JetStream stream of the messages. When set, the messages are consumed by a durable consumer, and acknowledged once their Triggers were processed. Otherwise, the messages are received by a queue subscription, and are lost if their processing fails.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consumer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Consumer is the name of the durable consumer of the Stream. Defaults to the name of the EventListener followed by the name of the source.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a Secret, in the namespace of the EventListener, holding the credentials of the NATS server: either a token key, or the username and password keys of a kubernetes.io/basic-auth Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "subject"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_NamespaceSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -876,91 +1402,295 @@ func schema_pkg_apis_triggers_v1beta1_NamespaceSelector(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_triggers_v1beta1_Param(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_triggers_v1beta1_Param(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Param defines a string value to be used for a ParamSpec with the same name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_ParamSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ParamSpec defines an arbitrary named  input whose value can be supplied by a `Param`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name declares the name by which a parameter is referenced.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a user-facing description of the parameter that may be used to populate a UI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Default is the value a parameter takes if no input value via a Param is supplied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Replay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Replay configures the events an EventListener replica keeps in memory, and the admin endpoint used to list and replay them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEvents is the number of most recent events kept. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"redactHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RedactHeaders are headers redacted from the listed events, in addition to the headers that usually hold credentials or signatures.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tokenRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenRef refers to the key of a Secret, in the namespace of the EventListener, holding the bearer token of the admin endpoint.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"),
+						},
+					},
+				},
+				Required: []string{"tokenRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_RequestMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RequestMatch restricts a Trigger or TriggerGroup to incoming requests with a specific URL path and, optionally, HTTP method.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URL path that incoming requests must have, e.g. /github. A trailing slash is ignored. Requests with any path match if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"methods": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods are the HTTP methods that incoming requests must use. Requests with any method match if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Resources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetesResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"),
+						},
+					},
+					"customResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Response(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Param defines a string value to be used for a ParamSpec with the same name.",
+				Description: "Response configures the responses of an EventListener to the requests that do not select a response mode with the Tekton-Triggers-Response-Mode header or the responseMode query parameter.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Mode is the response mode of the requests. Defaults to async.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Timeout is how long a synchronous response waits for the Triggers, including for the requests that select the sync mode. Defaults to the sync response timeout of the EventListener, 4s. It must be shorter than the timeout of the handler of the EventListener, 5s by default.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"name", "value"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_triggers_v1beta1_ParamSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_triggers_v1beta1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ParamSpec defines an arbitrary named  input whose value can be supplied by a `Param`.",
+				Description: "RetryPolicy describes how the creation of resources is retried, and where the resources are sent once retries are exhausted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"maxAttempts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name declares the name by which a parameter is referenced.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxAttempts is the maximum number of attempts to create each resource, including the first one. Defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"description": {
+					"backoff": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a user-facing description of the parameter that may be used to populate a UI.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Backoff is the delay before the first retry. The delay doubles for each following retry. Defaults to 1s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"default": {
+					"retryOn": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Default is the value a parameter takes if no input value via a Param is supplied.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RetryOn lists the errors for which the creation is retried. Defaults to Unavailable and Timeout.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deadLetter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeadLetter receives the resources that could not be created, with the event they were rendered from, once retries are exhausted.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetter"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.DeadLetter", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_triggers_v1beta1_Resources(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_triggers_v1beta1_Schedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Schedule generates events on a cron schedule.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetesResource": {
+					"cron": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"),
+							Description: "Cron is the schedule in the standard cron format, e.g. \"0 2 * * *\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"customResource": {
+					"timeZone": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource"),
+							Description: "TimeZone is the IANA time zone of the schedule, e.g. \"Europe/Paris\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the JSON body of the generated events. $(schedule.time) is replaced with the time of the event in the strings of the body. Defaults to an empty object.",
+							Ref:         ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
 						},
 					},
 				},
+				Required: []string{"cron"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"},
+			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
 	}
 }
 
@@ -1036,6 +1766,79 @@ func schema_pkg_apis_triggers_v1beta1_StatusError(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_TokenReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenReview configures the Kubernetes bearer tokens accepted by an EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"audiences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Audiences are the audiences the tokens must be issued for. Defaults to the audiences of the API server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"users": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Users are the users allowed to send requests, e.g. system:serviceaccount:<namespace>:<name>. The requests of other authenticated users are forbidden. All authenticated users are allowed if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_Transaction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Transaction configures how the resources of an event are created all together or not at all.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun creates all the resources with a server-side dry-run first, so that none of them is created if any of them is invalid.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_Trigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1368,6 +2171,34 @@ func schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_TriggerRuns(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerRuns configures the TriggerRuns recorded by an EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is how long a TriggerRun is kept after it completed. Defaults to 24h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"recordParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordParams records the params resolved from each event in the status of its TriggerRuns. They are not recorded by default since they may hold values of the event that must not be readable by every user allowed to read TriggerRuns.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1428,12 +2259,42 @@ func schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Match optionally restricts the Trigger to requests with a specific URL path and HTTP method",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch"),
+						},
+					},
+					"retryPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryPolicy optionally retries the creation of resources that failed, and sends them to a dead letter once retries are exhausted. It takes precedence over the retry policy of the EventListener.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency optionally limits the PipelineRuns and TaskRuns created by the Trigger that run together for the same concurrency group.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Concurrency"),
+						},
+					},
+					"debounce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Debounce optionally coalesces the events with the same key received within a window, so that the Trigger only processes the latest one.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debounce"),
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule optionally makes the Trigger process events generated on a cron schedule instead of the events received by the EventListener.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Schedule"),
+						},
+					},
 				},
 				Required: []string{"bindings", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Concurrency", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Debounce", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RequestMatch", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.RetryPolicy", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Schedule", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
 	}
 }
 
@@ -1656,11 +2517,17 @@ func schema_pkg_apis_triggers_v1beta1_TriggerTemplateSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"transaction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Transaction optionally creates the resources of an event all together or not at all",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Transaction"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ParamSpec", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerResourceTemplate"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ParamSpec", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Transaction", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerResourceTemplate"},
 	}
 }
 
@@ -1675,6 +2542,85 @@ func schema_pkg_apis_triggers_v1beta1_TriggerTemplateStatus(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_WatchSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WatchSource watches Kubernetes resources, and generates an event for each of their changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group of the resources, empty for the core group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the resources, e.g. v1.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the plural name of the resources, e.g. pipelineruns.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the resources. Defaults to the namespace of the EventListener. Ignored for cluster-scoped resources.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector optionally selects the resources by label.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"fieldSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldSelector optionally selects the resources by field, e.g. metadata.name=my-config.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"eventTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTypes are the changes that generate events: add, update or delete. Defaults to all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"version", "resource"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_WebhookInterceptor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1719,3 +2665,40 @@ func schema_pkg_apis_triggers_v1beta1_WebhookInterceptor(ref common.ReferenceCal
 			"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1.Param", "k8s.io/api/core/v1.ObjectReference", "knative.dev/pkg/apis.URL"},
 	}
 }
+
+func schema_pkg_apis_triggers_v1beta1_Workers(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Workers configures how many Triggers and TriggerGroups an EventListener replica processes at the same time, and how many can wait to be processed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrency is the maximum number of Triggers and TriggerGroups processed at the same time.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"queueSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueueSize is the maximum number of Triggers and TriggerGroups waiting for a worker. Requests are rejected when the queue is full. Defaults to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryAfter is the delay sent in the Retry-After header of rejected requests. Defaults to 10s.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"maxConcurrency"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
@@ -32,8 +32,39 @@ type TriggerTemplateSpec struct {
 	Params []ParamSpec `json:"params,omitempty"`
 	// +listType=atomic
 	ResourceTemplates []TriggerResourceTemplate `json:"resourcetemplates,omitempty"`
+	// Transaction optionally creates the resources of an event all together
+	// or not at all
+	// +optional
+	Transaction *Transaction `json:"transaction,omitempty"`
+}
+
+// Transaction configures how the resources of an event are created all
+// together or not at all.
+type Transaction struct {
+	// DryRun creates all the resources with a server-side dry-run first, so
+	// that none of them is created if any of them is invalid.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
+// ResourceActionAnnotation is the annotation of a resource template that
+// specifies the action applied to its resource. It defaults to create.
+const ResourceActionAnnotation = "triggers.tekton.dev/action"
+
+// ResourceAction is the action applied to the resource of a resource template.
+type ResourceAction string
+
+const (
+	// ResourceActionCreate creates the resource.
+	ResourceActionCreate ResourceAction = "create"
+	// ResourceActionApply creates or updates the resource with a server-side apply.
+	ResourceActionApply ResourceAction = "apply"
+	// ResourceActionPatch merges the resource template into an existing resource.
+	ResourceActionPatch ResourceAction = "patch"
+	// ResourceActionDelete deletes the resource.
+	ResourceActionDelete ResourceAction = "delete"
+)
+
 // TriggerResourceTemplate describes a resource to create
 type TriggerResourceTemplate struct {
 	runtime.RawExtension `json:",inline"`
@@ -72,6 +72,27 @@ func validateResourceTemplates(templates []TriggerResourceTemplate) (errs *apis.
 		if data.GetAPIVersion() == "" {
 			errs = errs.Also(apis.ErrMissingField(fmt.Sprintf("[%d].apiVersion", i)))
 		}
+
+		errs = errs.Also(validateResourceAction(data).ViaIndex(i))
+	}
+	return errs
+}
+
+func validateResourceAction(data *unstructured.Unstructured) (errs *apis.FieldError) {
+	action, ok := data.GetAnnotations()[ResourceActionAnnotation]
+	if !ok {
+		return nil
+	}
+	field := fmt.Sprintf("metadata.annotations.%s", ResourceActionAnnotation)
+	switch ResourceAction(action) {
+	case ResourceActionCreate:
+	case ResourceActionApply, ResourceActionPatch, ResourceActionDelete:
+		// These actions target a single existing or named resource
+		if data.GetName() == "" {
+			errs = errs.Also(apis.ErrMissingField("metadata.name"))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(action, field, "action must be one of create, apply, patch or delete"))
 	}
 	return errs
 }
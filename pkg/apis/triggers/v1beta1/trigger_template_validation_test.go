@@ -24,6 +24,7 @@ import (
 	pipelinev1alpha1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1alpha1"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/ptr"
 
@@ -59,6 +60,19 @@ func v1alpha1ResourceTemplate(t *testing.T) runtime.RawExtension {
 	})
 }
 
+func actionResourceTemplate(t *testing.T, name, action string) runtime.RawExtension {
+	return test.RawExtension(t, corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{v1beta1.ResourceActionAnnotation: action},
+		},
+	})
+}
+
 func paramResourceTemplate(t *testing.T) runtime.RawExtension {
 	return test.RawExtension(t, pipelinev1.PipelineRun{
 		TypeMeta: metav1.TypeMeta{
@@ -261,6 +275,27 @@ func TestTriggerTemplate_Validate(t *testing.T) {
 			},
 		},
 		want: nil,
+	}, {
+		name: "resource templates with actions",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: actionResourceTemplate(t, "latest-commit", "apply"),
+				}, {
+					RawExtension: actionResourceTemplate(t, "", "create"),
+				}, {
+					RawExtension: actionResourceTemplate(t, "", "patch"),
+				}, {
+					RawExtension: actionResourceTemplate(t, "latest-commit", "update"),
+				}},
+			},
+		},
+		want: apis.ErrMissingField("spec.resourcetemplates[2].metadata.name").Also(
+			apis.ErrInvalidValue("update", "spec.resourcetemplates[3].metadata.annotations.triggers.tekton.dev/action", "action must be one of create, apply, patch or delete")),
 	}, {
 		name: "tt.params used in resource template are declared",
 		template: &v1beta1.TriggerTemplate{
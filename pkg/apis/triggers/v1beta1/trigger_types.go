@@ -17,6 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -40,6 +44,262 @@ type TriggerSpec struct {
 	// as the Trigger itself
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Match optionally restricts the Trigger to requests with a specific
+	// URL path and HTTP method
+	// +optional
+	Match *RequestMatch `json:"match,omitempty"`
+	// RetryPolicy optionally retries the creation of resources that failed,
+	// and sends them to a dead letter once retries are exhausted. It takes
+	// precedence over the retry policy of the EventListener.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// Concurrency optionally limits the PipelineRuns and TaskRuns created by
+	// the Trigger that run together for the same concurrency group.
+	// +optional
+	Concurrency *Concurrency `json:"concurrency,omitempty"`
+	// Debounce optionally coalesces the events with the same key received
+	// within a window, so that the Trigger only processes the latest one.
+	// +optional
+	Debounce *Debounce `json:"debounce,omitempty"`
+	// Schedule optionally makes the Trigger process events generated on a
+	// cron schedule instead of the events received by the EventListener.
+	// +optional
+	Schedule *Schedule `json:"schedule,omitempty"`
+}
+
+// RequestMatch restricts a Trigger or TriggerGroup to incoming requests with a
+// specific URL path and, optionally, HTTP method.
+type RequestMatch struct {
+	// Path is the URL path that incoming requests must have, e.g. /github.
+	// A trailing slash is ignored. Requests with any path match if empty.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Methods are the HTTP methods that incoming requests must use.
+	// Requests with any method match if empty.
+	// +listType=atomic
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+}
+
+// Matches returns true if the request matches the path and methods. A nil
+// RequestMatch matches every request.
+func (m *RequestMatch) Matches(r *http.Request) bool {
+	if m == nil {
+		return true
+	}
+	if m.Path != "" && normalizePath(m.Path) != normalizePath(r.URL.Path) {
+		return false
+	}
+	if len(m.Methods) == 0 {
+		return true
+	}
+	for _, method := range m.Methods {
+		if strings.EqualFold(method, r.Method) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizePath(p string) string {
+	if p != "/" {
+		p = strings.TrimSuffix(p, "/")
+	}
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+// RetryCondition is a category of errors for which the creation of a resource is retried.
+type RetryCondition string
+
+const (
+	// RetryOnUnavailable retries when the API server is unavailable, overloaded
+	// or fails with an internal error, e.g. because of a failing admission webhook.
+	RetryOnUnavailable RetryCondition = "Unavailable"
+	// RetryOnTimeout retries when the request to the API server times out.
+	RetryOnTimeout RetryCondition = "Timeout"
+	// RetryOnConflict retries when the request conflicts with a concurrent change of the resource.
+	RetryOnConflict RetryCondition = "Conflict"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the number of attempts used when a RetryPolicy
+	// does not specify one.
+	DefaultRetryMaxAttempts = 3
+	// DefaultRetryBackoff is the backoff used when a RetryPolicy does not specify one.
+	DefaultRetryBackoff = time.Second
+)
+
+// RetryPolicy describes how the creation of resources is retried, and where
+// the resources are sent once retries are exhausted.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to create each resource,
+	// including the first one. Defaults to 3.
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// Backoff is the delay before the first retry. The delay doubles for each
+	// following retry. Defaults to 1s.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// RetryOn lists the errors for which the creation is retried.
+	// Defaults to Unavailable and Timeout.
+	// +listType=atomic
+	// +optional
+	RetryOn []RetryCondition `json:"retryOn,omitempty"`
+	// DeadLetter receives the resources that could not be created, with the
+	// event they were rendered from, once retries are exhausted.
+	// +optional
+	DeadLetter *DeadLetter `json:"deadLetter,omitempty"`
+}
+
+// GetMaxAttempts returns MaxAttempts, or DefaultRetryMaxAttempts if it is not set.
+func (p *RetryPolicy) GetMaxAttempts() int32 {
+	if p.MaxAttempts == nil {
+		return DefaultRetryMaxAttempts
+	}
+	return *p.MaxAttempts
+}
+
+// GetBackoff returns Backoff, or DefaultRetryBackoff if it is not set.
+func (p *RetryPolicy) GetBackoff() time.Duration {
+	if p.Backoff == nil {
+		return DefaultRetryBackoff
+	}
+	return p.Backoff.Duration
+}
+
+// GetRetryOn returns RetryOn, or Unavailable and Timeout if it is not set.
+func (p *RetryPolicy) GetRetryOn() []RetryCondition {
+	if len(p.RetryOn) == 0 {
+		return []RetryCondition{RetryOnUnavailable, RetryOnTimeout}
+	}
+	return p.RetryOn
+}
+
+// DeadLetter is the destination of resources that could not be created.
+// Exactly one of URI and ConfigMap must be set.
+type DeadLetter struct {
+	// URI is the address of a CloudEvents sink to send the dead letters to.
+	// +optional
+	URI string `json:"uri,omitempty"`
+	// ConfigMap stores each dead letter in a ConfigMap in the namespace of the Trigger.
+	// +optional
+	ConfigMap *DeadLetterConfigMap `json:"configMap,omitempty"`
+}
+
+// DeadLetterConfigMap configures the ConfigMaps storing dead letters.
+type DeadLetterConfigMap struct {
+	// GenerateName is the prefix of the name of the ConfigMaps.
+	// Defaults to the name of the Trigger followed by "-dead-letter-".
+	// +optional
+	GenerateName string `json:"generateName,omitempty"`
+}
+
+// ConcurrencyPolicy is what happens to the resources of an event when
+// resources of the same concurrency group are still running.
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyCancelInProgress cancels the running resources before
+	// creating the new ones.
+	ConcurrencyPolicyCancelInProgress ConcurrencyPolicy = "cancel-in-progress"
+	// ConcurrencyPolicyQueue holds the new resources back until the running
+	// resources complete.
+	ConcurrencyPolicyQueue ConcurrencyPolicy = "queue"
+	// ConcurrencyPolicySkipIfRunning does not create the new resources.
+	ConcurrencyPolicySkipIfRunning ConcurrencyPolicy = "skip-if-running"
+)
+
+// Concurrency groups the PipelineRuns and TaskRuns created by a Trigger so
+// that only the ones of a single event run at a time in each group.
+type Concurrency struct {
+	// Key is the concurrency group of the event. It can reference the event
+	// after the interceptors ran, e.g. $(body.pull_request.number).
+	Key string `json:"key"`
+	// Policy is what happens when resources of the same group are still
+	// running. Defaults to queue.
+	// +optional
+	Policy ConcurrencyPolicy `json:"policy,omitempty"`
+	// MaxQueued is the maximum number of events waiting for their turn in
+	// each group. Newer events fail when it is reached. Defaults to 10.
+	// +optional
+	MaxQueued *int32 `json:"maxQueued,omitempty"`
+	// QueueTimeout is how long an event waits for its turn in its group
+	// before it fails. Defaults to 1h.
+	// +optional
+	QueueTimeout *metav1.Duration `json:"queueTimeout,omitempty"`
+}
+
+const (
+	// DefaultConcurrencyMaxQueued is the maximum number of events waiting in
+	// a group when Concurrency does not specify one.
+	DefaultConcurrencyMaxQueued = 10
+	// DefaultConcurrencyQueueTimeout is how long an event waits in a group
+	// when Concurrency does not specify it.
+	DefaultConcurrencyQueueTimeout = time.Hour
+)
+
+// GetPolicy returns Policy, or ConcurrencyPolicyQueue if it is not set.
+func (c *Concurrency) GetPolicy() ConcurrencyPolicy {
+	if c.Policy == "" {
+		return ConcurrencyPolicyQueue
+	}
+	return c.Policy
+}
+
+// GetMaxQueued returns MaxQueued, or DefaultConcurrencyMaxQueued if it is not set.
+func (c *Concurrency) GetMaxQueued() int {
+	if c.MaxQueued == nil {
+		return DefaultConcurrencyMaxQueued
+	}
+	return int(*c.MaxQueued)
+}
+
+// GetQueueTimeout returns QueueTimeout, or DefaultConcurrencyQueueTimeout if it is not set.
+func (c *Concurrency) GetQueueTimeout() time.Duration {
+	if c.QueueTimeout == nil {
+		return DefaultConcurrencyQueueTimeout
+	}
+	return c.QueueTimeout.Duration
+}
+
+// Debounce coalesces bursts of events for a Trigger. An event is processed
+// once no newer event with the same key was received during the window.
+// Events are held by the EventListener replica that received them, so the
+// events of a burst are only coalesced when the same replica receives them.
+type Debounce struct {
+	// Key identifies the events coalesced together. It can reference the
+	// event after the interceptors ran, e.g. $(body.ref).
+	Key string `json:"key"`
+	// Window is how long the Trigger waits for a newer event with the same
+	// key before processing an event.
+	Window metav1.Duration `json:"window"`
+}
+
+// ScheduleTimeVar is replaced with the time of the event in the body of a
+// Schedule.
+const ScheduleTimeVar = "$(schedule.time)"
+
+// Schedule generates events on a cron schedule.
+type Schedule struct {
+	// Cron is the schedule in the standard cron format, e.g. "0 2 * * *".
+	Cron string `json:"cron"`
+	// TimeZone is the IANA time zone of the schedule, e.g. "Europe/Paris".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Body is the JSON body of the generated events. $(schedule.time) is
+	// replaced with the time of the event in the strings of the body.
+	// Defaults to an empty object.
+	// +optional
+	Body *apiextensionsv1.JSON `json:"body,omitempty"`
+}
+
+// GetLocation returns the location of the TimeZone of the schedule.
+func (s *Schedule) GetLocation() (*time.Location, error) {
+	return time.LoadLocation(s.TimeZone)
 }
 
 type TriggerSpecTemplate struct {
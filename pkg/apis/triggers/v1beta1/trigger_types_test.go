@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -45,3 +47,67 @@ func TestGetName(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestMatchMatches(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		match  *RequestMatch
+		method string
+		target string
+		want   bool
+	}{{
+		name:   "nil match",
+		method: http.MethodPost,
+		target: "/anything",
+		want:   true,
+	}, {
+		name:   "empty match",
+		match:  &RequestMatch{},
+		method: http.MethodGet,
+		target: "/",
+		want:   true,
+	}, {
+		name:   "matching path",
+		match:  &RequestMatch{Path: "/github"},
+		method: http.MethodPost,
+		target: "/github?foo=bar",
+		want:   true,
+	}, {
+		name:   "trailing slash is ignored",
+		match:  &RequestMatch{Path: "/github/"},
+		method: http.MethodPost,
+		target: "/github",
+		want:   true,
+	}, {
+		name:   "different path",
+		match:  &RequestMatch{Path: "/github"},
+		method: http.MethodPost,
+		target: "/gitlab",
+		want:   false,
+	}, {
+		name:   "root path only matches root",
+		match:  &RequestMatch{Path: "/"},
+		method: http.MethodPost,
+		target: "/github",
+		want:   false,
+	}, {
+		name:   "matching path and method",
+		match:  &RequestMatch{Path: "/github", Methods: []string{http.MethodPut, http.MethodPost}},
+		method: http.MethodPost,
+		target: "/github",
+		want:   true,
+	}, {
+		name:   "different method",
+		match:  &RequestMatch{Path: "/github", Methods: []string{http.MethodPut}},
+		method: http.MethodPost,
+		target: "/github",
+		want:   false,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			if got := tc.match.Matches(req); got != tc.want {
+				t.Errorf("Matches() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,19 +18,45 @@ package v1beta1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/robfig/cron/v3"
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/webhook/resourcesemantics"
 )
 
 var _ resourcesemantics.VerbLimited = (*Trigger)(nil)
 
+var (
+	// reservedPaths, and the paths under /admin/ and /debug/, are served by the
+	// EventListener sink itself and cannot be used to match Triggers.
+	reservedPaths = sets.NewString("/live", "/ready", "/admin", "/debug")
+
+	retryConditions = sets.NewString(
+		string(RetryOnUnavailable),
+		string(RetryOnTimeout),
+		string(RetryOnConflict),
+	)
+
+	httpMethods = sets.NewString(
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPost,
+		http.MethodPut,
+		http.MethodPatch,
+		http.MethodDelete,
+		http.MethodOptions,
+	)
+)
+
 // SupportedVerbs returns the operations that validation should be called for
 func (t *Trigger) SupportedVerbs() []admissionregistrationv1.OperationType {
 	return []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update}
@@ -53,6 +79,21 @@ func (t *TriggerSpec) validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(interceptor.validate(ctx).ViaField(fmt.Sprintf("interceptors[%d]", i)))
 	}
 
+	// Validate optional Match
+	errs = errs.Also(t.Match.validate(ctx).ViaField("match"))
+
+	// Validate optional RetryPolicy
+	errs = errs.Also(t.RetryPolicy.validate(ctx).ViaField("retryPolicy"))
+
+	// Validate optional Concurrency
+	errs = errs.Also(t.Concurrency.validate().ViaField("concurrency"))
+
+	// Validate optional Debounce
+	errs = errs.Also(t.Debounce.validate().ViaField("debounce"))
+
+	// Validate optional Schedule
+	errs = errs.Also(t.Schedule.validate().ViaField("schedule"))
+
 	return errs
 }
 
@@ -143,3 +184,113 @@ func (i *TriggerInterceptor) validate(ctx context.Context) (errs *apis.FieldErro
 	}
 	return errs
 }
+
+func (m *RequestMatch) validate(ctx context.Context) (errs *apis.FieldError) {
+	if m == nil {
+		return nil
+	}
+	if m.Path != "" {
+		if !strings.HasPrefix(m.Path, "/") {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("path %q must start with '/'", m.Path), "path"))
+		} else if p := normalizePath(m.Path); reservedPaths.Has(p) || strings.HasPrefix(p, "/admin/") || strings.HasPrefix(p, "/debug/") {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("path %q is reserved by the EventListener", m.Path), "path"))
+		}
+	}
+	for i, method := range m.Methods {
+		if !httpMethods.Has(method) {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("unsupported HTTP method %q", method), fmt.Sprintf("methods[%d]", i)))
+		}
+	}
+	return errs
+}
+
+func (p *RetryPolicy) validate(ctx context.Context) (errs *apis.FieldError) {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts != nil && *p.MaxAttempts < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*p.MaxAttempts, "maxAttempts", "maxAttempts must be at least 1"))
+	}
+	if p.Backoff != nil && p.Backoff.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(p.Backoff.Duration.String(), "backoff", "backoff must be positive"))
+	}
+	for i, c := range p.RetryOn {
+		if !retryConditions.Has(string(c)) {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("unsupported retry condition %q", c), fmt.Sprintf("retryOn[%d]", i)))
+		}
+	}
+	if p.DeadLetter != nil {
+		errs = errs.Also(p.DeadLetter.validate(ctx).ViaField("deadLetter"))
+	}
+	return errs
+}
+
+func (c *Concurrency) validate() (errs *apis.FieldError) {
+	if c == nil {
+		return nil
+	}
+	if c.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	} else if strings.Contains(c.Key, "$(") {
+		errs = errs.Also(validateParamValue(c.Key).ViaField("key"))
+	}
+	switch c.Policy {
+	case "", ConcurrencyPolicyCancelInProgress, ConcurrencyPolicyQueue, ConcurrencyPolicySkipIfRunning:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(c.Policy, "policy", "policy must be one of cancel-in-progress, queue or skip-if-running"))
+	}
+	if c.MaxQueued != nil && *c.MaxQueued < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*c.MaxQueued, "maxQueued", "maxQueued must be at least 1"))
+	}
+	if c.QueueTimeout != nil && c.QueueTimeout.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.QueueTimeout.Duration.String(), "queueTimeout", "queueTimeout must be positive"))
+	}
+	return errs
+}
+
+func (d *Debounce) validate() (errs *apis.FieldError) {
+	if d == nil {
+		return nil
+	}
+	if d.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	} else if strings.Contains(d.Key, "$(") {
+		errs = errs.Also(validateParamValue(d.Key).ViaField("key"))
+	}
+	if d.Window.Duration <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(d.Window.Duration.String(), "window", "window must be positive"))
+	}
+	return errs
+}
+
+func (s *Schedule) validate() (errs *apis.FieldError) {
+	if s == nil {
+		return nil
+	}
+	if s.Cron == "" {
+		errs = errs.Also(apis.ErrMissingField("cron"))
+	} else if _, err := cron.ParseStandard(s.Cron); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(s.Cron, "cron", err.Error()))
+	}
+	if _, err := s.GetLocation(); err != nil || s.TimeZone == "Local" {
+		errs = errs.Also(apis.ErrInvalidValue(s.TimeZone, "timeZone", "timeZone must be an IANA time zone, e.g. Europe/Paris"))
+	}
+	if s.Body != nil && !json.Valid(s.Body.Raw) {
+		errs = errs.Also(apis.ErrInvalidValue(string(s.Body.Raw), "body", "body must be valid JSON"))
+	}
+	return errs
+}
+
+func (d *DeadLetter) validate(ctx context.Context) (errs *apis.FieldError) {
+	switch {
+	case d.URI == "" && d.ConfigMap == nil:
+		return apis.ErrMissingOneOf("uri", "configMap")
+	case d.URI != "" && d.ConfigMap != nil:
+		return apis.ErrMultipleOneOf("uri", "configMap")
+	case d.URI != "":
+		if u, err := apis.ParseURL(d.URI); err != nil || !u.URL().IsAbs() {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("uri %q must be an absolute URL", d.URI), "uri"))
+		}
+	}
+	return errs
+}
@@ -19,11 +19,13 @@ package v1beta1_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/pkg/interceptors/cel"
 	"github.com/tektoncd/triggers/test"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/ptr"
 )
@@ -218,6 +220,45 @@ func Test_TriggerValidate(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "Trigger with concurrency",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Concurrency: &v1beta1.Concurrency{
+					Key:          "pr-$(body.pull_request.number)",
+					Policy:       v1beta1.ConcurrencyPolicyCancelInProgress,
+					MaxQueued:    ptr.Int32(5),
+					QueueTimeout: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+		},
+	}, {
+		name: "Trigger with debounce",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Debounce: &v1beta1.Debounce{
+					Key:    "$(body.ref)",
+					Window: metav1.Duration{Duration: 30 * time.Second},
+				},
+			},
+		},
+	}, {
+		name: "Trigger with schedule",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.Schedule{
+					Cron:     "0 2 * * 1-5",
+					TimeZone: "Europe/Paris",
+					Body:     &apiextensionsv1.JSON{Raw: []byte(`{"job": "nightly", "time": "$(schedule.time)"}`)},
+				},
+			},
+		},
 	}}
 
 	for _, test := range tests {
@@ -400,6 +441,57 @@ func TestTriggerValidate_error(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "Retry policy with dead letter without destination",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				RetryPolicy: &v1beta1.RetryPolicy{
+					DeadLetter: &v1beta1.DeadLetter{},
+				},
+			},
+		},
+	}, {
+		name: "Concurrency without key",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template:    v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Concurrency: &v1beta1.Concurrency{Policy: v1beta1.ConcurrencyPolicyQueue},
+			},
+		},
+	}, {
+		name: "Concurrency with invalid queue limits",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Concurrency: &v1beta1.Concurrency{
+					Key:          "$(body.ref)",
+					MaxQueued:    ptr.Int32(0),
+					QueueTimeout: &metav1.Duration{},
+				},
+			},
+		},
+	}, {
+		name: "Debounce without window",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Debounce: &v1beta1.Debounce{Key: "$(body.ref)"},
+			},
+		},
+	}, {
+		name: "Schedule with invalid cron",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.Schedule{Cron: "every night"},
+			},
+		},
 	}}
 
 	for _, test := range tests {
@@ -22,6 +22,9 @@ import (
 
 const (
 	PayloadValidationAnnotation = "tekton.dev/payload-validation"
+	// InProcessInterceptorsAnnotation runs the core interceptors in the
+	// EventListener process when set to true.
+	InProcessInterceptorsAnnotation = "tekton.dev/in-process-interceptors"
 )
 
 func ValidateAnnotations(annotations map[string]string) *apis.FieldError {
@@ -33,5 +36,11 @@ func ValidateAnnotations(annotations map[string]string) *apis.FieldError {
 		}
 	}
 
+	if value, ok := annotations[InProcessInterceptorsAnnotation]; ok {
+		if value != "true" && value != "false" {
+			errs = errs.Also(apis.ErrInvalidValue(InProcessInterceptorsAnnotation+" annotation must have value 'true' or 'false'", "metadata.annotations"))
+		}
+	}
+
 	return errs
 }
@@ -35,3 +35,12 @@ func Test_PayloadValidationAnnotation_InvalidValue(t *testing.T) {
 		t.Errorf("Expected Error but got nil")
 	}
 }
+
+func Test_InProcessInterceptorsAnnotation(t *testing.T) {
+	if err := ValidateAnnotations(map[string]string{InProcessInterceptorsAnnotation: "true"}); err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+	if err := ValidateAnnotations(map[string]string{InProcessInterceptorsAnnotation: "yes"}); err == nil {
+		t.Errorf("Expected Error but got nil")
+	}
+}
@@ -52,6 +52,10 @@ func (c *FakeTriggersV1alpha1) TriggerBindings(namespace string) v1alpha1.Trigge
 	return newFakeTriggerBindings(c, namespace)
 }
 
+func (c *FakeTriggersV1alpha1) TriggerRuns(namespace string) v1alpha1.TriggerRunInterface {
+	return newFakeTriggerRuns(c, namespace)
+}
+
 func (c *FakeTriggersV1alpha1) TriggerTemplates(namespace string) v1alpha1.TriggerTemplateInterface {
 	return newFakeTriggerTemplates(c, namespace)
 }
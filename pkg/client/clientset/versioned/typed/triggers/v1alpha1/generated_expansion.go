@@ -30,4 +30,6 @@ type TriggerExpansion interface{}
 
 type TriggerBindingExpansion interface{}
 
+type TriggerRunExpansion interface{}
+
 type TriggerTemplateExpansion interface{}
@@ -34,6 +34,7 @@ type TriggersV1alpha1Interface interface {
 	InterceptorsGetter
 	TriggersGetter
 	TriggerBindingsGetter
+	TriggerRunsGetter
 	TriggerTemplatesGetter
 }
 
@@ -66,6 +67,10 @@ func (c *TriggersV1alpha1Client) TriggerBindings(namespace string) TriggerBindin
 	return newTriggerBindings(c, namespace)
 }
 
+func (c *TriggersV1alpha1Client) TriggerRuns(namespace string) TriggerRunInterface {
+	return newTriggerRuns(c, namespace)
+}
+
 func (c *TriggersV1alpha1Client) TriggerTemplates(namespace string) TriggerTemplateInterface {
 	return newTriggerTemplates(c, namespace)
 }
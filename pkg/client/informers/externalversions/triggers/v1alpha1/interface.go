@@ -36,6 +36,8 @@ type Interface interface {
 	Triggers() TriggerInformer
 	// TriggerBindings returns a TriggerBindingInformer.
 	TriggerBindings() TriggerBindingInformer
+	// TriggerRuns returns a TriggerRunInformer.
+	TriggerRuns() TriggerRunInformer
 	// TriggerTemplates returns a TriggerTemplateInformer.
 	TriggerTemplates() TriggerTemplateInformer
 }
@@ -81,6 +83,11 @@ func (v *version) TriggerBindings() TriggerBindingInformer {
 	return &triggerBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TriggerRuns returns a TriggerRunInformer.
+func (v *version) TriggerRuns() TriggerRunInformer {
+	return &triggerRunInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TriggerTemplates returns a TriggerTemplateInformer.
 func (v *version) TriggerTemplates() TriggerTemplateInformer {
 	return &triggerTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
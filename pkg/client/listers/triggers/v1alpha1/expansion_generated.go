@@ -58,6 +58,14 @@ type TriggerBindingListerExpansion interface{}
 // TriggerBindingNamespaceLister.
 type TriggerBindingNamespaceListerExpansion interface{}
 
+// TriggerRunListerExpansion allows custom methods to be added to
+// TriggerRunLister.
+type TriggerRunListerExpansion interface{}
+
+// TriggerRunNamespaceListerExpansion allows custom methods to be added to
+// TriggerRunNamespaceLister.
+type TriggerRunNamespaceListerExpansion interface{}
+
 // TriggerTemplateListerExpansion allows custom methods to be added to
 // TriggerTemplateLister.
 type TriggerTemplateListerExpansion interface{}
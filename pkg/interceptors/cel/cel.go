@@ -33,6 +33,7 @@ import (
 	"github.com/google/cel-go/common/types/traits"
 	celext "github.com/google/cel-go/ext"
 	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
 	"github.com/tidwall/sjson"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -114,20 +115,29 @@ func makeCelEnv(ctx context.Context, ns string, sg interceptors.SecretGetter) (*
 			decls.NewVariable("header", mapStrDyn),
 			decls.NewVariable("extensions", mapStrDyn),
 			decls.NewVariable("requestURL", types.StringType),
+			decls.NewVariable("ce", mapStrDyn),
 		))
 }
 
 func makeEvalContext(body []byte, h http.Header, url string, extensions map[string]interface{}) (map[string]interface{}, error) {
-	var jsonMap map[string]interface{}
-	err := json.Unmarshal(body, &jsonMap)
+	data, err := template.DecodeBody(body, h)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse the body as JSON: %w", err)
+		return nil, err
+	}
+	jsonMap, ok := data.(map[string]interface{})
+	if data != nil && !ok {
+		return nil, fmt.Errorf("failed to parse the body: %T is not an object", data)
+	}
+	ce := template.CloudEventContext(h)
+	if ce == nil {
+		ce = map[string]interface{}{}
 	}
 	return map[string]interface{}{
 		"body":       jsonMap,
 		"header":     h,
 		"requestURL": url,
 		"extensions": extensions,
+		"ce":         ce,
 	}, nil
 }
 
@@ -298,6 +298,96 @@ func TestInterceptor_Process(t *testing.T) {
 	}
 }
 
+func TestInterceptor_Process_CloudEvent(t *testing.T) {
+	header := http.Header{
+		"Content-Type":   []string{"application/json"},
+		"Ce-Specversion": []string{"1.0"},
+		"Ce-Id":          []string{"event-1"},
+		"Ce-Source":      []string{"/builds"},
+		"Ce-Type":        []string{"dev.tekton.build.finished"},
+		"Ce-Team":        []string{"ci"},
+	}
+	for _, tt := range []struct {
+		name         string
+		header       http.Header
+		filter       string
+		wantContinue bool
+	}{{
+		name:         "matching type and extension",
+		header:       header,
+		filter:       "ce.type == 'dev.tekton.build.finished' && ce.extensions.team == 'ci'",
+		wantContinue: true,
+	}, {
+		name:         "other type",
+		header:       header,
+		filter:       "ce.type == 'dev.tekton.build.started'",
+		wantContinue: false,
+	}, {
+		name:         "not a CloudEvent",
+		header:       http.Header{"Content-Type": []string{"application/json"}},
+		filter:       "!has(ce.type)",
+		wantContinue: true,
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &InterceptorImpl{}
+			res := w.Process(context.Background(), &triggersv1.InterceptorRequest{
+				Body:              `{}`,
+				Header:            tt.header,
+				InterceptorParams: map[string]interface{}{"filter": tt.filter},
+				Context: &triggersv1.TriggerContext{
+					EventURL:  "https://testing.example.com",
+					EventID:   "abcde",
+					TriggerID: fmt.Sprintf("namespaces/%s/triggers/example-trigger", testNS),
+				},
+			})
+			if res.Continue != tt.wantContinue {
+				t.Errorf("cel.Process() returned continue: %t, want %t. Response is: %v", res.Continue, tt.wantContinue, res.Status.Err())
+			}
+		})
+	}
+}
+
+func TestInterceptor_Process_DecodedBody(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		body        string
+		contentType string
+		filter      string
+	}{{
+		name:        "XML",
+		body:        `<build status="passed"><name>triggers</name></build>`,
+		contentType: "application/xml",
+		filter:      "body.build['-status'] == 'passed' && body.build.name == 'triggers'",
+	}, {
+		name:        "YAML",
+		body:        "build:\n  status: passed\n",
+		contentType: "application/yaml",
+		filter:      "body.build.status == 'passed'",
+	}, {
+		name:        "form data with a JSON payload",
+		body:        `payload=%7B%22status%22%3A%22passed%22%7D`,
+		contentType: "application/x-www-form-urlencoded",
+		filter:      "body.payload.status == 'passed'",
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &InterceptorImpl{}
+			res := w.Process(context.Background(), &triggersv1.InterceptorRequest{
+				Body:              tt.body,
+				Header:            http.Header{"Content-Type": []string{tt.contentType}},
+				InterceptorParams: map[string]interface{}{"filter": tt.filter},
+				Context: &triggersv1.TriggerContext{
+					EventURL:  "https://testing.example.com",
+					EventID:   "abcde",
+					TriggerID: fmt.Sprintf("namespaces/%s/triggers/example-trigger", testNS),
+				},
+			})
+			if !res.Continue {
+				t.Errorf("cel.Process() returned continue: false, want true. Response is: %v", res.Status.Err())
+			}
+		})
+	}
+}
+
 func TestInterceptor_Process_Error(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -167,7 +167,10 @@ func (w *InterceptorImpl) Process(ctx context.Context, r *triggersv1.Interceptor
 		}
 
 		if err := gh.ValidateSignature(header, []byte(r.Body), secretToken); err != nil {
-			return interceptors.Fail(codes.FailedPrecondition, err.Error())
+			// The signature of a compressed body may be computed over the compressed bytes
+			if len(r.RawBody) == 0 || gh.ValidateSignature(header, r.RawBody, secretToken) != nil {
+				return interceptors.Fail(codes.FailedPrecondition, err.Error())
+			}
 		}
 	}
 
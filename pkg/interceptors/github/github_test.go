@@ -42,6 +42,7 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 		name              string
 		interceptorParams *InterceptorParams
 		payload           []byte
+		rawBody           []byte
 		secret            *corev1.Secret
 		headers           map[string][]string
 		eventType         string
@@ -86,6 +87,25 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 			},
 		},
 		payload: emptyJSONBody,
+	}, {
+		name: "valid header for the compressed body",
+		interceptorParams: &InterceptorParams{
+			SecretRef: &triggersv1.SecretRef{
+				SecretName: "mysecret",
+				SecretKey:  "token",
+			},
+		},
+		headers: emptyBodySha256Header,
+		secret: &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "mysecret",
+			},
+			Data: map[string][]byte{
+				"token": []byte(secretToken),
+			},
+		},
+		payload: json.RawMessage(`{"decompressed": true}`),
+		rawBody: emptyJSONBody,
 	}, {
 		name: "no secret, matching event",
 		interceptorParams: &InterceptorParams{
@@ -133,8 +153,9 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 			}
 
 			req := &triggersv1.InterceptorRequest{
-				Body:   string(tt.payload),
-				Header: headers,
+				Body:    string(tt.payload),
+				RawBody: tt.rawBody,
+				Header:  headers,
 				InterceptorParams: map[string]interface{}{
 					"eventTypes": tt.interceptorParams.EventTypes,
 					"secretRef":  tt.interceptorParams.SecretRef,
@@ -131,25 +131,54 @@ func internal(err error) HTTPError {
 }
 
 func (is *Server) ExecuteInterceptor(r *http.Request) ([]byte, error) {
+	var body bytes.Buffer
+	defer r.Body.Close()
+	if _, err := io.Copy(&body, r.Body); err != nil {
+		return nil, internal(fmt.Errorf("failed to read body: %w", err))
+	}
+	return is.execute(r.Context(), r.URL.Path, body.Bytes())
+}
+
+// Handles returns true if an interceptor is served at the path.
+func (is *Server) Handles(path string) bool {
+	_, ok := is.interceptors[strings.TrimPrefix(strings.ToLower(path), "/")]
+	return ok
+}
+
+// Execute runs the interceptor served at the path in-process. The request and
+// the response are encoded to JSON as when they are sent over HTTP, so that
+// the interceptor behaves the same.
+func (is *Server) Execute(ctx context.Context, path string, req *triggersv1.InterceptorRequest) (*triggersv1.InterceptorResponse, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	respBytes, err := is.execute(ctx, path, b)
+	if err != nil {
+		return nil, err
+	}
+	var iresp triggersv1.InterceptorResponse
+	if err := json.Unmarshal(respBytes, &iresp); err != nil {
+		return nil, err
+	}
+	return &iresp, nil
+}
+
+func (is *Server) execute(ctx context.Context, path string, body []byte) ([]byte, error) {
 	var ii triggersv1.InterceptorInterface
 
 	// Find correct interceptor
-	ii, ok := is.interceptors[strings.TrimPrefix(strings.ToLower(r.URL.Path), "/")]
+	ii, ok := is.interceptors[strings.TrimPrefix(strings.ToLower(path), "/")]
 	if !ok {
 		return nil, badRequest(errors.New("path did not match any interceptors"))
 	}
 
 	// Create a context
-	ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
-	var body bytes.Buffer
-	defer r.Body.Close()
-	if _, err := io.Copy(&body, r.Body); err != nil {
-		return nil, internal(fmt.Errorf("failed to read body: %w", err))
-	}
 	var ireq triggersv1.InterceptorRequest
-	if err := json.Unmarshal(body.Bytes(), &ireq); err != nil {
+	if err := json.Unmarshal(body, &ireq); err != nil {
 		return nil, badRequest(fmt.Errorf("failed to parse body as InterceptorRequest: %w", err))
 	}
 	is.Logger.Debugf("Interceptor Request is: %+v", ireq)
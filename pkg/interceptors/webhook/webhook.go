@@ -83,6 +83,12 @@ func (w *Interceptor) ExecuteTrigger(request *http.Request) (*http.Response, err
 	return resp, err
 }
 
+// ResolveURL returns the URL the webhook interceptor sends the events of the
+// Triggers in namespace ns to.
+func ResolveURL(webhook *triggersv1.WebhookInterceptor, ns string) (*url.URL, error) {
+	return getURI(webhook, ns)
+}
+
 // getURI retrieves the ObjectReference to URI.
 func getURI(webhook *triggersv1.WebhookInterceptor, ns string) (*url.URL, error) {
 	// TODO: This should work for any Addressable.
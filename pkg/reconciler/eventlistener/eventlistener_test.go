@@ -191,7 +191,8 @@ func makeDeployment(ops ...func(d *appsv1.Deployment)) *appsv1.Deployment {
 					Labels: generatedLabels,
 				},
 				Spec: corev1.PodSpec{
-					ServiceAccountName: "sa",
+					ServiceAccountName:            "sa",
+					TerminationGracePeriodSeconds: ptr.Int64(45),
 					Containers: []corev1.Container{{
 						Name:  "event-listener",
 						Image: resources.DefaultImage,
@@ -216,7 +217,7 @@ func makeDeployment(ops ...func(d *appsv1.Deployment)) *appsv1.Deployment {
 						ReadinessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
-									Path:   "/live",
+									Path:   "/ready",
 									Scheme: corev1.URISchemeHTTP,
 									Port:   intstr.FromInt(eventListenerContainerPort),
 								},
@@ -415,6 +416,7 @@ func makeWithPod(ops ...func(d *duckv1.WithPod)) *duckv1.WithPod {
 							"--is-multi-ns=" + strconv.FormatBool(false),
 							"--payload-validation=" + strconv.FormatBool(true),
 							"--cloudevent-uri=",
+							"--shutdown-delay=0",
 						},
 						Env: []corev1.EnvVar{{
 							Name: "K_LOGGING_CONFIG",
@@ -460,7 +462,7 @@ func makeWithPod(ops ...func(d *duckv1.WithPod)) *duckv1.WithPod {
 						ReadinessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
-									Path:   "/live",
+									Path:   "/ready",
 									Scheme: corev1.URISchemeHTTP,
 								},
 							},
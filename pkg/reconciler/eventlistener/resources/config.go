@@ -49,6 +49,8 @@ var (
 	DefaultHTTPClientResponseHeaderTimeout = int64(10)
 	// DefaultHTTPClientExpectContinueTimeout is the HTTPClient Expect Continue Timeout
 	DefaultHTTPClientExpectContinueTimeout = int64(1)
+	// DefaultAuthOverride is how the Triggers authenticate as their service account by default.
+	DefaultAuthOverride = "impersonation"
 	// DefaultStaticResourceLabels are the StaticResourceLabels used by default.
 	DefaultStaticResourceLabels = map[string]string{
 		"app.kubernetes.io/managed-by": "EventListener",
@@ -91,6 +93,9 @@ type Config struct {
 	PeriodSeconds *int
 	// FailureThreshold defines the Failure Threshold for the EventListener Liveness and Readiness Probes.
 	FailureThreshold *int
+	// AuthOverride defines how the Triggers authenticate as their service account,
+	// either impersonation or tokenrequest.
+	AuthOverride *string
 	// StaticResourceLabels is a map with all the labels that should be on all resources generated by the EventListener.
 	StaticResourceLabels map[string]string
 	// SystemNamespace is the namespace where the reconciler is deployed.
@@ -119,6 +124,7 @@ func MakeConfig(ops ...ConfigOption) *Config {
 		HTTPClientExpectContinueTimeout: &DefaultHTTPClientExpectContinueTimeout,
 		PeriodSeconds:                   &DefaultPeriodSeconds,
 		FailureThreshold:                &DefaultFailureThreshold,
+		AuthOverride:                    &DefaultAuthOverride,
 
 		StaticResourceLabels: DefaultStaticResourceLabels,
 		SystemNamespace:      DefaultSystemNamespace,
@@ -17,7 +17,10 @@ limitations under the License.
 package resources
 
 import (
+	"fmt"
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
@@ -29,6 +32,19 @@ import (
 
 type ContainerOption func(*corev1.Container)
 
+// secretEnvVar returns an environment variable set to the key of a Secret.
+func secretEnvVar(name string, ref v1beta1.SecretRef) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.SecretName},
+				Key:                  ref.SecretKey,
+			},
+		},
+	}
+}
+
 func MakeContainer(el *v1beta1.EventListener, configAcc reconcilersource.ConfigAccessor, c Config, cfg *config.Config, opts ...ContainerOption) corev1.Container {
 	isMultiNS := false
 	if len(el.Spec.NamespaceSelector.MatchNames) != 0 {
@@ -81,6 +97,84 @@ func MakeContainer(el *v1beta1.EventListener, configAcc reconcilersource.ConfigA
 		}
 	}
 
+	args := []string{
+		"--el-name=" + el.Name,
+		"--el-namespace=" + el.Namespace,
+		"--port=" + strconv.Itoa(eventListenerContainerPort),
+		"--readtimeout=" + strconv.FormatInt(*c.ReadTimeOut, 10),
+		"--writetimeout=" + strconv.FormatInt(*c.WriteTimeOut, 10),
+		"--idletimeout=" + strconv.FormatInt(*c.IdleTimeOut, 10),
+		"--timeouthandler=" + strconv.FormatInt(*c.TimeOutHandler, 10),
+		"--httpclient-readtimeout=" + strconv.FormatInt(*c.HTTPClientReadTimeOut, 10),
+		"--httpclient-keep-alive=" + strconv.FormatInt(*c.HTTPClientKeepAlive, 10),
+		"--httpclient-tlshandshaketimeout=" + strconv.FormatInt(*c.HTTPClientTLSHandshakeTimeout, 10),
+		"--httpclient-responseheadertimeout=" + strconv.FormatInt(*c.HTTPClientResponseHeaderTimeout, 10),
+		"--httpclient-expectcontinuetimeout=" + strconv.FormatInt(*c.HTTPClientExpectContinueTimeout, 10),
+		"--is-multi-ns=" + strconv.FormatBool(isMultiNS),
+		"--payload-validation=" + strconv.FormatBool(payloadValidation),
+		"--cloudevent-uri=" + el.Spec.CloudEventURI,
+	}
+	if el.GetAnnotations()[triggers.InProcessInterceptorsAnnotation] == "true" {
+		args = append(args, "--in-process-interceptors=true")
+	}
+	if c.AuthOverride != nil && *c.AuthOverride != DefaultAuthOverride {
+		args = append(args, "--auth-override="+*c.AuthOverride)
+	}
+	if w := el.Spec.Workers; w != nil {
+		args = append(args,
+			"--max-concurrency="+strconv.FormatInt(int64(w.MaxConcurrency), 10),
+			"--queue-size="+strconv.FormatInt(int64(w.GetQueueSize()), 10),
+			"--retry-after="+strconv.FormatInt(int64(math.Ceil(w.GetRetryAfter().Seconds())), 10),
+		)
+	}
+	if el.Spec.MaxBodySize != nil {
+		args = append(args, "--max-body-size="+strconv.FormatInt(el.Spec.MaxBodySize.Value(), 10))
+	}
+	if el.Spec.ShutdownGracePeriod != nil {
+		args = append(args, "--shutdown-grace-period="+strconv.FormatInt(int64(math.Ceil(el.Spec.ShutdownGracePeriod.Seconds())), 10))
+	}
+	switch {
+	case el.Spec.Resources.CustomResource != nil:
+		// The terminationGracePeriodSeconds of the pods of a custom resource
+		// is not raised to fit the delay, and Knative Services already drain
+		// their requests
+		args = append(args, "--shutdown-delay=0")
+	case el.Spec.ShutdownDelay != nil:
+		args = append(args, "--shutdown-delay="+strconv.FormatInt(int64(math.Ceil(el.Spec.ShutdownDelay.Seconds())), 10))
+	}
+	if rp := el.Spec.Replay; rp != nil {
+		args = append(args, "--replay-max-events="+strconv.FormatInt(int64(rp.GetMaxEvents()), 10))
+		if len(rp.RedactHeaders) != 0 {
+			args = append(args, "--replay-redact-headers="+strings.Join(rp.RedactHeaders, ","))
+		}
+		ev = append(ev, secretEnvVar("EL_REPLAY_TOKEN", rp.TokenRef))
+	}
+	if d := el.Spec.Debug; d != nil {
+		args = append(args, "--debug-max-decisions="+strconv.FormatInt(int64(d.GetMaxDecisions()), 10))
+		ev = append(ev, secretEnvVar("EL_DEBUG_TOKEN", d.TokenRef))
+	}
+	if auth := el.Spec.Authentication; auth != nil {
+		args = append(args, "--authentication=true")
+		for i, ref := range auth.TokenRefs {
+			ev = append(ev, secretEnvVar(fmt.Sprintf("EL_AUTH_TOKEN_%d", i), ref))
+		}
+		if auth.APIKeyHeader != "" {
+			args = append(args, "--auth-api-key-header="+auth.APIKeyHeader)
+		}
+		if auth.ClientCARef != nil {
+			ev = append(ev, secretEnvVar("EL_AUTH_CLIENT_CA", *auth.ClientCARef))
+		}
+		if tr := auth.TokenReview; tr != nil {
+			args = append(args, "--auth-token-review=true")
+			if len(tr.Audiences) != 0 {
+				args = append(args, "--auth-token-review-audiences="+strings.Join(tr.Audiences, ","))
+			}
+			if len(tr.Users) != 0 {
+				args = append(args, "--auth-token-review-users="+strings.Join(tr.Users, ","))
+			}
+		}
+	}
+
 	container := corev1.Container{
 		Name:  "event-listener",
 		Image: *c.Image,
@@ -88,23 +182,7 @@ func MakeContainer(el *v1beta1.EventListener, configAcc reconcilersource.ConfigA
 			ContainerPort: int32(eventListenerContainerPort),
 			Protocol:      corev1.ProtocolTCP,
 		}},
-		Args: []string{
-			"--el-name=" + el.Name,
-			"--el-namespace=" + el.Namespace,
-			"--port=" + strconv.Itoa(eventListenerContainerPort),
-			"--readtimeout=" + strconv.FormatInt(*c.ReadTimeOut, 10),
-			"--writetimeout=" + strconv.FormatInt(*c.WriteTimeOut, 10),
-			"--idletimeout=" + strconv.FormatInt(*c.IdleTimeOut, 10),
-			"--timeouthandler=" + strconv.FormatInt(*c.TimeOutHandler, 10),
-			"--httpclient-readtimeout=" + strconv.FormatInt(*c.HTTPClientReadTimeOut, 10),
-			"--httpclient-keep-alive=" + strconv.FormatInt(*c.HTTPClientKeepAlive, 10),
-			"--httpclient-tlshandshaketimeout=" + strconv.FormatInt(*c.HTTPClientTLSHandshakeTimeout, 10),
-			"--httpclient-responseheadertimeout=" + strconv.FormatInt(*c.HTTPClientResponseHeaderTimeout, 10),
-			"--httpclient-expectcontinuetimeout=" + strconv.FormatInt(*c.HTTPClientExpectContinueTimeout, 10),
-			"--is-multi-ns=" + strconv.FormatBool(isMultiNS),
-			"--payload-validation=" + strconv.FormatBool(payloadValidation),
-			"--cloudevent-uri=" + el.Spec.CloudEventURI,
-		},
+		Args: args,
 		Env: append(ev, []corev1.EnvVar{{
 			Name:  "NAMESPACE",
 			Value: el.Namespace,
@@ -69,6 +69,7 @@ func TestCustomObject(t *testing.T) {
 		"--is-multi-ns=" + strconv.FormatBool(false),
 		"--payload-validation=" + strconv.FormatBool(true),
 		"--cloudevent-uri=",
+		"--shutdown-delay=0",
 	}
 
 	containerEnv := []interface{}{
@@ -163,7 +164,7 @@ func TestCustomObject(t *testing.T) {
 									"resources": map[string]interface{}{},
 									"readinessProbe": map[string]interface{}{
 										"httpGet": map[string]interface{}{
-											"path":   "/live",
+											"path":   "/ready",
 											"port":   int64(0),
 											"scheme": "HTTP",
 										},
@@ -238,7 +239,7 @@ func TestCustomObject(t *testing.T) {
 									"resources": map[string]interface{}{},
 									"readinessProbe": map[string]interface{}{
 										"httpGet": map[string]interface{}{
-											"path":   "/live",
+											"path":   "/ready",
 											"port":   int64(0),
 											"scheme": "HTTP",
 										},
@@ -313,7 +314,7 @@ func TestCustomObject(t *testing.T) {
 									},
 									"readinessProbe": map[string]interface{}{
 										"httpGet": map[string]interface{}{
-											"path":   "/live",
+											"path":   "/ready",
 											"port":   int64(0),
 											"scheme": "HTTP",
 										},
@@ -429,7 +430,7 @@ func TestCustomObject(t *testing.T) {
 									},
 									"readinessProbe": map[string]interface{}{
 										"httpGet": map[string]interface{}{
-											"path":   "/live",
+											"path":   "/ready",
 											"port":   int64(0),
 											"scheme": "HTTP",
 										},
@@ -599,7 +600,7 @@ func TestUpdateCustomObject(t *testing.T) {
 									},
 									"readinessProbe": map[string]interface{}{
 										"httpGet": map[string]interface{}{
-											"path":   "/live",
+											"path":   "/ready",
 											"port":   int64(0),
 											"scheme": "HTTP",
 										},
@@ -18,8 +18,10 @@ package resources
 
 import (
 	"context"
+	"math"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
@@ -34,6 +36,11 @@ import (
 
 const (
 	TriggersMetricsDomain = "tekton.dev/triggers"
+
+	// terminationGracePeriodMargin is added to the ShutdownDelay and the
+	// ShutdownGracePeriod of an EventListener so that it can log the events it
+	// abandons before it is killed
+	terminationGracePeriodMargin = 5 * time.Second
 )
 
 var (
@@ -131,6 +138,10 @@ func MakeDeployment(ctx context.Context, el *v1beta1.EventListener, configAcc re
 		securityContext = getStrongerSecurityPolicy(cfg)
 	}
 
+	// The EventListener keeps accepting requests for the ShutdownDelay, then
+	// waits for the events being processed for the ShutdownGracePeriod
+	terminationGracePeriod := ptr.Int64(int64(math.Ceil((el.Spec.GetShutdownDelay() + el.Spec.GetShutdownGracePeriod() + terminationGracePeriodMargin).Seconds())))
+
 	return &appsv1.Deployment{
 		ObjectMeta: ObjectMeta(el, filteredLabels, c.StaticResourceLabels),
 		Spec: appsv1.DeploymentSpec{
@@ -153,6 +164,8 @@ func MakeDeployment(ctx context.Context, el *v1beta1.EventListener, configAcc re
 					SecurityContext:           securityContext,
 					Affinity:                  affinity,
 					TopologySpreadConstraints: topologySpreadConstraints,
+					// The EventListener drains the events it accepted when it is terminated
+					TerminationGracePeriodSeconds: terminationGracePeriod,
 				},
 			},
 		},
@@ -250,7 +263,7 @@ func addCertsForSecureConnection(c Config) ContainerOption {
 			container.ReadinessProbe = &corev1.Probe{
 				ProbeHandler: corev1.ProbeHandler{
 					HTTPGet: &corev1.HTTPGetAction{
-						Path:   "/live",
+						Path:   "/ready",
 						Scheme: scheme,
 						Port:   intstr.FromInt(eventListenerContainerPort),
 					},
@@ -19,6 +19,7 @@ package resources
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	cfg "github.com/tektoncd/triggers/pkg/apis/config"
@@ -68,7 +69,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
@@ -79,6 +81,41 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "with shutdown grace period",
+		el: makeEL(func(el *v1beta1.EventListener) {
+			el.Spec.ShutdownGracePeriod = &metav1.Duration{Duration: time.Minute}
+		}),
+		want: &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "",
+				Namespace:       namespace,
+				Labels:          labels,
+				OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: labels,
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: labels,
+					},
+					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.Int64(80),
+						ServiceAccountName:            "sa",
+						Containers: []corev1.Container{
+							MakeContainer(makeEL(func(el *v1beta1.EventListener) {
+								el.Spec.ShutdownGracePeriod = &metav1.Duration{Duration: time.Minute}
+							}), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
+								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
+								addCertsForSecureConnection(resourcesConfig)),
+						},
+						SecurityContext: expectedSecurityContext,
+					},
+				},
+			},
+		},
 	}, {
 		name: "with replicas",
 		el: makeEL(func(el *v1beta1.EventListener) {
@@ -103,7 +140,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
@@ -146,7 +184,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
@@ -192,7 +231,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
@@ -235,7 +275,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "bob",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "bob",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
@@ -265,7 +306,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(withTLSEnvFrom("Bill")), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(withTLSEnvFrom("Bill")), resourcesConfig),
@@ -303,7 +345,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Affinity: &corev1.Affinity{
 							NodeAffinity: &corev1.NodeAffinity{
 								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
@@ -349,7 +392,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
@@ -380,7 +424,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								getConfigWithoverriddenRunAsGroupAndRunAsUserAndFsGroup("0"), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
@@ -411,7 +456,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								getConfigWithoverriddenRunAsGroupAndRunAsUserAndFsGroup(""), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
@@ -441,7 +487,8 @@ func TestDeployment(t *testing.T) {
 						Labels: labels,
 					},
 					Spec: corev1.PodSpec{
-						ServiceAccountName: "sa",
+						TerminationGracePeriodSeconds: ptr.Int64(45),
+						ServiceAccountName:            "sa",
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setSecurityContext()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(setSecurityContext()), resourcesConfig),
@@ -510,6 +557,7 @@ func withTLSEnvFrom(name string) func(*v1beta1.EventListener) {
 			WithPodSpec: duckv1.WithPodSpec{
 				Template: duckv1.PodSpecable{
 					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.Int64(45),
 						Containers: []corev1.Container{{
 							Env: []corev1.EnvVar{{
 								Name: "TLS_CERT",
@@ -546,6 +594,7 @@ func withAffinityAndTopologySpreadConstraints() func(*v1beta1.EventListener) {
 			WithPodSpec: duckv1.WithPodSpec{
 				Template: duckv1.PodSpecable{
 					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.Int64(45),
 						Affinity: &corev1.Affinity{
 							NodeAffinity: &corev1.NodeAffinity{
 								RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
@@ -583,6 +632,7 @@ func setProbes() func(*v1beta1.EventListener) {
 			WithPodSpec: duckv1.WithPodSpec{
 				Template: duckv1.PodSpecable{
 					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.Int64(45),
 						Containers: []corev1.Container{{
 							ReadinessProbe: &corev1.Probe{
 								InitialDelaySeconds: 10,
@@ -607,6 +657,7 @@ func setSecurityContext() func(*v1beta1.EventListener) {
 			WithPodSpec: duckv1.WithPodSpec{
 				Template: duckv1.PodSpecable{
 					Spec: corev1.PodSpec{
+						TerminationGracePeriodSeconds: ptr.Int64(45),
 						SecurityContext: &corev1.PodSecurityContext{
 							RunAsNonRoot: ptr.Bool(true),
 							RunAsGroup:   ptr.Int64(1000),
@@ -33,6 +33,9 @@ const (
 	TriggerProcessingDoneV1 = "dev.tekton.event.triggers.done.v1"
 	// EventAccepted is sent as response for CloudEvent compliant providers
 	EventAccepted = "dev.tekton.event.triggers.accepted.v1"
+	// TriggerDeadLetterV1 is sent to the dead letter URI of a Trigger when
+	// its resources could not be created
+	TriggerDeadLetterV1 = "dev.tekton.event.triggers.deadletter.v1"
 )
 
 // Emit emits events for object
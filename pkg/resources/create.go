@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"k8s.io/client-go/dynamic"
@@ -32,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	discoveryclient "k8s.io/client-go/discovery"
 )
 
@@ -61,13 +63,55 @@ func findAPIResource(apiVersion, kind string, c discoveryclient.ServerResourcesI
 	return nil, fmt.Errorf("error could not find resource with apiVersion %s and kind %s", apiVersion, kind)
 }
 
+// FieldManager is the field manager of the resources applied by Triggers.
+const FieldManager = "tekton-triggers"
+
 // Create uses the kubeClient to create the resource defined in the
-// TriggerResourceTemplate and returns any errors with this process
-func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) error {
+// TriggerResourceTemplate and returns the created resource or any errors with this process.
+// The resource is applied, patched or deleted instead if its resource template has
+// the corresponding action annotation.
+func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) (*unstructured.Unstructured, error) {
+	return create(logger, rt, triggerName, eventID, elName, elNamespace, c, dc, nil)
+}
+
+// DryRunCreate creates the resource defined in the TriggerResourceTemplate
+// with a server-side dry-run, which validates the resource without persisting it.
+func DryRunCreate(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) error {
+	_, err := create(logger, rt, triggerName, eventID, elName, elNamespace, c, dc, []string{metav1.DryRunAll})
+	return err
+}
+
+// Action returns the action of the resource template, which defaults to create.
+func Action(rt json.RawMessage) triggersv1.ResourceAction {
+	data := new(unstructured.Unstructured)
+	if err := data.UnmarshalJSON(rt); err != nil {
+		return triggersv1.ResourceActionCreate
+	}
+	return action(data)
+}
+
+func action(data *unstructured.Unstructured) triggersv1.ResourceAction {
+	if a, ok := data.GetAnnotations()[triggersv1.ResourceActionAnnotation]; ok {
+		return triggersv1.ResourceAction(a)
+	}
+	return triggersv1.ResourceActionCreate
+}
+
+func create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface, dryRun []string) (*unstructured.Unstructured, error) {
 	// Assume the TriggerResourceTemplate is valid (it has an apiVersion and Kind)
 	data := new(unstructured.Unstructured)
 	if err := data.UnmarshalJSON(rt); err != nil {
-		return fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
+		return nil, fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
+	}
+
+	// The action annotation configures Triggers and is not part of the resource
+	act := action(data)
+	if annotations := data.GetAnnotations(); annotations != nil {
+		delete(annotations, triggersv1.ResourceActionAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		data.SetAnnotations(annotations)
 	}
 
 	data, err := addLabels(data, map[string]string{
@@ -76,7 +120,7 @@ func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID,
 		triggers.TriggerLabelKey:       triggerName,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	namespace := data.GetNamespace()
@@ -86,30 +130,95 @@ func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID,
 	}
 
 	// Resolve resource kind to the underlying API Resource type.
-	apiResource, err := findAPIResource(data.GetAPIVersion(), data.GetKind(), c)
+	gvr, err := GroupVersionResource(data.GetAPIVersion(), data.GetKind(), c)
 	if err != nil {
-		return fmt.Errorf("couldn't find API resource for json: %w", err)
+		return nil, fmt.Errorf("couldn't find API resource for json: %w", err)
 	}
 
 	name := data.GetName()
 	if name == "" {
 		name = data.GetGenerateName()
 	}
-	logger.Infof("Generating resource: kind: %s, name: %s", apiResource, name)
+	logger.Infof("Generating resource: kind: %s, name: %s", gvr, name)
 
-	gvr := schema.GroupVersionResource{
-		Group:    apiResource.Group,
-		Version:  apiResource.Version,
-		Resource: apiResource.Name,
+	if len(dryRun) > 0 {
+		logger.Infof("For event ID %q running %s of resource %v with a dry-run", eventID, act, gvr)
+	} else {
+		logger.Infof("For event ID %q running %s of resource %v", eventID, act, gvr)
 	}
 
-	logger.Infof("For event ID %q creating resource %v", eventID, gvr)
-
-	if _, err := dc.Resource(gvr).Namespace(namespace).Create(context.Background(), data, metav1.CreateOptions{}); err != nil {
+	ri := dc.Resource(gvr).Namespace(namespace)
+	var obj *unstructured.Unstructured
+	switch act {
+	case triggersv1.ResourceActionApply:
+		obj, err = ri.Apply(context.Background(), name, data, metav1.ApplyOptions{
+			FieldManager: FieldManager,
+			Force:        true,
+			DryRun:       dryRun,
+		})
+	case triggersv1.ResourceActionPatch:
+		var patch []byte
+		if patch, err = data.MarshalJSON(); err == nil {
+			obj, err = ri.Patch(context.Background(), name, types.MergePatchType, patch, metav1.PatchOptions{
+				FieldManager: FieldManager,
+				DryRun:       dryRun,
+			})
+		}
+	case triggersv1.ResourceActionDelete:
+		propagation := metav1.DeletePropagationBackground
+		err = ri.Delete(context.Background(), name, metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+			DryRun:            dryRun,
+		})
+		if kerrors.IsNotFound(err) {
+			err = nil
+		}
+		// The resource template identifies the resource that was deleted
+		data.SetNamespace(namespace)
+		obj = data
+	default:
+		obj, err = ri.Create(context.Background(), data, metav1.CreateOptions{DryRun: dryRun})
+	}
+	if err != nil {
 		if kerrors.IsUnauthorized(err) || kerrors.IsForbidden(err) {
-			return err
+			return nil, err
 		}
-		return fmt.Errorf("couldn't create resource with group version kind %q: %w", gvr, err)
+		return nil, fmt.Errorf("couldn't %s resource with group version kind %q: %w", act, gvr, err)
+	}
+	return obj, nil
+}
+
+// GroupVersionResource resolves the apiVersion and kind of a resource to
+// the GroupVersionResource used to call the dynamic client.
+// A CachedDiscovery c resolves them with its RESTMapper.
+func GroupVersionResource(apiVersion, kind string, c discoveryclient.ServerResourcesInterface) (schema.GroupVersionResource, error) {
+	if m, ok := c.(resourceMapper); ok {
+		return m.ResourceFor(apiVersion, kind)
+	}
+	apiResource, err := findAPIResource(apiVersion, kind, c)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return schema.GroupVersionResource{
+		Group:    apiResource.Group,
+		Version:  apiResource.Version,
+		Resource: apiResource.Name,
+	}, nil
+}
+
+// Delete deletes a resource returned by Create, along with its dependents.
+func Delete(logger *zap.SugaredLogger, obj *unstructured.Unstructured, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) error {
+	gvr, err := GroupVersionResource(obj.GetAPIVersion(), obj.GetKind(), c)
+	if err != nil {
+		return fmt.Errorf("couldn't find API resource for %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	logger.Infof("Deleting resource %v %s/%s", gvr, obj.GetNamespace(), obj.GetName())
+	propagation := metav1.DeletePropagationBackground
+	err = dc.Resource(gvr).Namespace(obj.GetNamespace()).Delete(context.Background(), obj.GetName(), metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("couldn't delete resource %s %s/%s: %w", gvr, obj.GetNamespace(), obj.GetName(), err)
 	}
 	return nil
 }
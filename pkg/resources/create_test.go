@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
@@ -196,7 +197,7 @@ func TestCreateResource(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			dynamicClient.ClearActions()
-			if err := Create(logger.Sugar(), tt.json, triggerName, eventID, elName, elNamespace, kubeClient.Discovery(), dynamicClient); err != nil {
+			if _, err := Create(logger.Sugar(), tt.json, triggerName, eventID, elName, elNamespace, kubeClient.Discovery(), dynamicClient); err != nil {
 				t.Errorf("createTaskRun() returned error: %s", err)
 			}
 
@@ -218,6 +219,116 @@ func TestCreateResource(t *testing.T) {
 	}
 }
 
+func TestDryRunCreateAndDelete(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	test.AddTektonResources(kubeClient)
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	logger := zaptest.NewLogger(t).Sugar()
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1beta1", Resource: "taskruns"}
+	rt := json.RawMessage(`{"kind":"TaskRun","apiVersion":"tekton.dev/v1beta1","metadata":{"name":"my-taskrun"},"spec":{"taskRef":{"name":"my-task"}}}`)
+
+	if err := DryRunCreate(logger, rt, triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient); err != nil {
+		t.Fatalf("DryRunCreate() returned error: %s", err)
+	}
+	actions := dynamicClient.Actions()
+	if len(actions) != 1 {
+		t.Fatalf("got %d actions, want 1", len(actions))
+	}
+	create, ok := actions[0].(ktesting.CreateActionImpl)
+	if !ok || !cmp.Equal(create.CreateOptions.DryRun, []string{metav1.DryRunAll}) {
+		t.Errorf("got action %#v, want a dry-run create", actions[0])
+	}
+
+	// The fake client does not implement dry-runs, so the TaskRun is deleted from another one
+	dynamicClient = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	obj, err := Create(logger, rt, triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient)
+	if err != nil {
+		t.Fatalf("Create() returned error: %s", err)
+	}
+	dynamicClient.ClearActions()
+	if err := Delete(logger, obj, kubeClient.Discovery(), dynamicClient); err != nil {
+		t.Fatalf("Delete() returned error: %s", err)
+	}
+	propagation := metav1.DeletePropagationBackground
+	want := []ktesting.Action{ktesting.NewDeleteActionWithOptions(gvr, "foo", "my-taskrun", metav1.DeleteOptions{PropagationPolicy: &propagation})}
+	if diff := cmp.Diff(want, dynamicClient.Actions()); diff != "" {
+		t.Errorf("actions (-want, +got): %s", diff)
+	}
+	// Resources already deleted are ignored
+	if err := Delete(logger, obj, kubeClient.Discovery(), dynamicClient); err != nil {
+		t.Errorf("Delete() of a deleted resource returned error: %s", err)
+	}
+}
+
+func TestCreate_Actions(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	test.AddTektonResources(kubeClient)
+	logger := zaptest.NewLogger(t).Sugar()
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1beta1", Resource: "taskruns"}
+	template := func(action string) json.RawMessage {
+		return json.RawMessage(`{"kind":"TaskRun","apiVersion":"tekton.dev/v1beta1","metadata":{"name":"my-taskrun","annotations":{"triggers.tekton.dev/action":"` + action + `"}},"spec":{"taskRef":{"name":"my-task"}}}`)
+	}
+	want := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":       "TaskRun",
+		"apiVersion": "tekton.dev/v1beta1",
+		"metadata": map[string]interface{}{
+			"name": "my-taskrun",
+			"labels": map[string]interface{}{
+				resourceLabel: "foo-el",
+				triggerLabel:  triggerName,
+				eventIDLabel:  eventID,
+			},
+		},
+		"spec": map[string]interface{}{"taskRef": map[string]interface{}{"name": "my-task"}},
+	}}
+	wantJSON, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	force := true
+	propagation := metav1.DeletePropagationBackground
+
+	for _, tc := range []struct {
+		action string
+		want   ktesting.Action
+	}{{
+		action: "create",
+		want:   ktesting.NewCreateAction(gvr, "foo", want),
+	}, {
+		action: "apply",
+		want: ktesting.NewPatchActionWithOptions(gvr, "foo", "my-taskrun", types.ApplyPatchType, wantJSON, metav1.PatchOptions{
+			FieldManager: FieldManager,
+			Force:        &force,
+		}),
+	}, {
+		action: "patch",
+		want: ktesting.NewPatchActionWithOptions(gvr, "foo", "my-taskrun", types.MergePatchType, wantJSON, metav1.PatchOptions{
+			FieldManager: FieldManager,
+		}),
+	}, {
+		action: "delete",
+		want:   ktesting.NewDeleteActionWithOptions(gvr, "foo", "my-taskrun", metav1.DeleteOptions{PropagationPolicy: &propagation}),
+	}} {
+		t.Run(tc.action, func(t *testing.T) {
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+			// The fake client does not implement apply patches
+			dynamicClient.PrependReactor("patch", "taskruns", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return true, want.DeepCopy(), nil
+			})
+			obj, err := Create(logger, template(tc.action), triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient)
+			if err != nil {
+				t.Fatalf("Create() returned error: %s", err)
+			}
+			if obj.GetName() != "my-taskrun" {
+				t.Errorf("got resource %s, want my-taskrun", obj.GetName())
+			}
+			if diff := cmp.Diff([]ktesting.Action{tc.want}, dynamicClient.Actions()); diff != "" {
+				t.Errorf("actions (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
 func Test_AddLabels(t *testing.T) {
 	tests := []struct {
 		name        string
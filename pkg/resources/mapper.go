@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryclient "k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// DefaultRefreshInterval is how often a CachedDiscovery refreshes the
+// resources of the API server.
+const DefaultRefreshInterval = 10 * time.Minute
+
+// minRefreshInterval is how long a CachedDiscovery waits after a refresh
+// before refreshing again when a kind is not found, so that events creating
+// unknown kinds do not call the API server each time.
+const minRefreshInterval = 5 * time.Second
+
+// resourceMapper is implemented by the discovery clients that resolve the
+// kinds of resources without calling the API server each time.
+type resourceMapper interface {
+	ResourceFor(apiVersion, kind string) (schema.GroupVersionResource, error)
+}
+
+// CachedDiscovery is a discovery client that caches the resources of the API
+// server in memory. It resolves the kinds of the resources created by Triggers
+// with a RESTMapper over this cache, which is shared by all the calls of
+// Create. The cache is refreshed periodically, and when a kind is not found
+// so that newly installed CRDs can be used, at most once every
+// minRefreshInterval.
+type CachedDiscovery struct {
+	discoveryclient.CachedDiscoveryInterface
+	mapper          *restmapper.DeferredDiscoveryRESTMapper
+	refreshInterval time.Duration
+	// now is replaced in tests
+	now func() time.Time
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+}
+
+// NewCachedDiscovery returns a CachedDiscovery over the discovery client c,
+// refreshed every refreshInterval.
+func NewCachedDiscovery(c discoveryclient.DiscoveryInterface, refreshInterval time.Duration) *CachedDiscovery {
+	cached := memory.NewMemCacheClient(c)
+	return &CachedDiscovery{
+		CachedDiscoveryInterface: cached,
+		mapper:                   restmapper.NewDeferredDiscoveryRESTMapper(cached),
+		refreshInterval:          refreshInterval,
+		now:                      time.Now,
+		lastRefresh:              time.Now(),
+	}
+}
+
+// ResourceFor resolves the apiVersion and kind of a resource to the
+// GroupVersionResource used to call the dynamic client. It refreshes the
+// cache and retries once if the kind is not found, unless the cache was
+// refreshed less than minRefreshInterval ago.
+func (d *CachedDiscovery) ResourceFor(apiVersion, kind string) (schema.GroupVersionResource, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("error parsing GroupVersion: %w", err)
+	}
+	d.refreshIfStale()
+	mapping, err := d.mapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+	if meta.IsNoMatchError(err) && d.refreshOnMiss() {
+		mapping, err = d.mapper.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
+	}
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("error could not find resource with apiVersion %s and kind %s: %w", apiVersion, kind, err)
+	}
+	return mapping.Resource, nil
+}
+
+// ServerResourcesForGroupVersion returns the cached resources of the group
+// version. It refreshes the cache and retries once if the group version is
+// not found, unless the cache was refreshed less than minRefreshInterval ago.
+func (d *CachedDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	d.refreshIfStale()
+	list, err := d.CachedDiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+	if errors.Is(err, memory.ErrCacheNotFound) && d.refreshOnMiss() {
+		list, err = d.CachedDiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+	}
+	return list, err
+}
+
+// refreshIfStale refreshes the cache if it is older than the refresh interval.
+func (d *CachedDiscovery) refreshIfStale() {
+	d.mu.Lock()
+	stale := d.now().Sub(d.lastRefresh) >= d.refreshInterval
+	d.mu.Unlock()
+	if stale {
+		d.refresh()
+	}
+}
+
+// refreshOnMiss refreshes the cache after a kind or group version was not
+// found, unless it was refreshed less than minRefreshInterval ago. It returns
+// whether the cache was refreshed.
+func (d *CachedDiscovery) refreshOnMiss() bool {
+	d.mu.Lock()
+	if d.now().Sub(d.lastRefresh) < minRefreshInterval {
+		d.mu.Unlock()
+		return false
+	}
+	d.lastRefresh = d.now()
+	d.mu.Unlock()
+	d.mapper.Reset()
+	return true
+}
+
+// refresh invalidates the cache, which is filled again by the next call.
+func (d *CachedDiscovery) refresh() {
+	d.mu.Lock()
+	d.lastRefresh = d.now()
+	d.mu.Unlock()
+	d.mapper.Reset()
+}
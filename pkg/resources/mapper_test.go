@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/triggers/test"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryclient "k8s.io/client-go/discovery"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestCachedDiscovery(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	test.AddTektonResources(kubeClient)
+	d := NewCachedDiscovery(kubeClient.Discovery(), time.Minute)
+	now := time.Now()
+	d.now = func() time.Time { return now }
+	taskRuns := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1beta1", Resource: "taskruns"}
+
+	resolve := func(apiVersion, kind string, want schema.GroupVersionResource) {
+		t.Helper()
+		got, err := GroupVersionResource(apiVersion, kind, d)
+		if err != nil {
+			t.Fatalf("GroupVersionResource() returned unexpected error: %v", err)
+		}
+		if got != want {
+			t.Errorf("GroupVersionResource() = %v, want %v", got, want)
+		}
+	}
+
+	resolve("tekton.dev/v1beta1", "TaskRun", taskRuns)
+	calls := len(kubeClient.Actions())
+	resolve("tekton.dev/v1beta1", "TaskRun", taskRuns)
+	if got := len(kubeClient.Actions()); got != calls {
+		t.Errorf("resolving a kind again made %d discovery calls, want none", got-calls)
+	}
+
+	// A newly installed CRD is not found until the cache can be refreshed again
+	kubeClient.Resources = append(kubeClient.Resources, &metav1.APIResourceList{
+		GroupVersion: "example.com/v1",
+		APIResources: []metav1.APIResource{{Name: "widgets", Namespaced: true, Kind: "Widget"}},
+	})
+	if _, err := GroupVersionResource("example.com/v1", "Widget", d); err == nil {
+		t.Error("GroupVersionResource() refreshed the cache less than minRefreshInterval after it was refreshed")
+	}
+	now = now.Add(minRefreshInterval)
+	resolve("example.com/v1", "Widget", schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"})
+	if _, err := d.ServerResourcesForGroupVersion("example.com/v1"); err != nil {
+		t.Errorf("ServerResourcesForGroupVersion() returned unexpected error: %v", err)
+	}
+
+	// Unknown kinds refresh the cache at most once every minRefreshInterval
+	calls = len(kubeClient.Actions())
+	for i := 0; i < 10; i++ {
+		if _, err := GroupVersionResource("example.com/v1", "Gadget", d); err == nil {
+			t.Error("GroupVersionResource() did not return an error for an unknown kind")
+		}
+		if _, err := d.ServerResourcesForGroupVersion("example.com/v2"); err == nil {
+			t.Error("ServerResourcesForGroupVersion() did not return an error for an unknown group version")
+		}
+	}
+	if got := len(kubeClient.Actions()); got != calls {
+		t.Errorf("resolving unknown kinds made %d discovery calls, want none", got-calls)
+	}
+	now = now.Add(minRefreshInterval)
+	if _, err := GroupVersionResource("example.com/v1", "Gadget", d); err == nil {
+		t.Error("GroupVersionResource() did not return an error for an unknown kind")
+	}
+	if got := len(kubeClient.Actions()); got == calls {
+		t.Error("resolving an unknown kind after minRefreshInterval did not refresh the cache")
+	}
+
+	// The cache is refreshed periodically
+	calls = len(kubeClient.Actions())
+	now = now.Add(time.Minute)
+	resolve("tekton.dev/v1beta1", "TaskRun", taskRuns)
+	if got := len(kubeClient.Actions()); got == calls {
+		t.Error("resolving a kind after the refresh interval did not refresh the cache")
+	}
+}
+
+// discoveryServer returns an API server serving the discovery of the Tekton
+// resources, which responds after latency.
+func discoveryServer(b *testing.B, latency time.Duration) *httptest.Server {
+	b.Helper()
+	responses := map[string]interface{}{
+		"/api": &metav1.APIVersions{Versions: []string{"v1"}},
+		"/api/v1": &metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: []string{"create"}}},
+		},
+		"/apis": &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name:             "tekton.dev",
+			Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "tekton.dev/v1", Version: "v1"}},
+			PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "tekton.dev/v1", Version: "v1"},
+		}}},
+		"/apis/tekton.dev/v1": &metav1.APIResourceList{
+			GroupVersion: "tekton.dev/v1",
+			APIResources: []metav1.APIResource{{Name: "pipelineruns", Namespaced: true, Kind: "PipelineRun", Verbs: []string{"create"}}},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(latency)
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	b.Cleanup(ts.Close)
+	return ts
+}
+
+// BenchmarkCreate compares the creation of resources resolving their kind
+// with a discovery call, and with a CachedDiscovery.
+func BenchmarkCreate(b *testing.B) {
+	rt := json.RawMessage(`{"apiVersion":"tekton.dev/v1","kind":"PipelineRun","metadata":{"generateName":"run-"},"spec":{"pipelineRef":{"name":"pipeline"}}}`)
+	ts := discoveryServer(b, time.Millisecond)
+	c := discoveryclient.NewDiscoveryClientForConfigOrDie(&rest.Config{Host: ts.URL})
+
+	for _, bb := range []struct {
+		name      string
+		discovery discoveryclient.ServerResourcesInterface
+	}{{
+		name:      "discovery",
+		discovery: c,
+	}, {
+		name:      "cached",
+		discovery: NewCachedDiscovery(c, DefaultRefreshInterval),
+	}} {
+		b.Run(bb.name, func(b *testing.B) {
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+			// The fake client does not generate names
+			dynamicClient.PrependReactor("create", "pipelineruns", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return true, action.(ktesting.CreateAction).GetObject(), nil
+			})
+			logger := zap.NewNop().Sugar()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := Create(logger, rt, triggerName, eventID, "el", "ns", bb.discovery, dynamicClient); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
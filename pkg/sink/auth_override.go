@@ -17,15 +17,40 @@ limitations under the License.
 package sink
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	discoveryclient "k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
 
+const (
+	// AuthOverrideImpersonation selects the DefaultAuthOverride, which
+	// impersonates the service accounts of the Triggers.
+	AuthOverrideImpersonation = "impersonation"
+	// AuthOverrideTokenRequest selects the TokenRequestAuthOverride, which
+	// requests tokens for the service accounts of the Triggers.
+	AuthOverrideTokenRequest = "tokenrequest"
+
+	// DefaultClientTTL is how long a ClientPool keeps the client of a service account.
+	DefaultClientTTL = 10 * time.Minute
+	// DefaultTokenExpirationSeconds is the lifetime of the tokens requested
+	// by the TokenRequestAuthOverride when it does not specify one.
+	DefaultTokenExpirationSeconds = int64(3600)
+)
+
+// inClusterConfig returns the REST config the clients of the service accounts
+// are created from. It is replaced in tests.
+var inClusterConfig = rest.InClusterConfig
+
 // AuthOverride is an interface that constructs a discovery client for the ServerResourceInterface
 // and a dynamic client for the Tekton Resources, using the token provide as the bearer token in the
 // REST config used to build those client.  The other non-credential related parameters for the
@@ -40,7 +65,77 @@ type AuthOverride interface {
 		err error)
 }
 
+// ClientPool caches the dynamic clients of service accounts, keyed by their
+// namespace and name, so that they are not created for every event. A nil
+// ClientPool creates a client for every call.
+type ClientPool struct {
+	ttl time.Duration
+	// now is replaced in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	clients map[types.NamespacedName]pooledClient
+}
+
+type pooledClient struct {
+	client  dynamic.Interface
+	expires time.Time
+}
+
+// NewClientPool returns a ClientPool keeping the client of each service
+// account for ttl.
+func NewClientPool(ttl time.Duration) *ClientPool {
+	return &ClientPool{
+		ttl:     ttl,
+		now:     time.Now,
+		clients: map[types.NamespacedName]pooledClient{},
+	}
+}
+
+// get returns the client of the service account, created with newClient if it
+// is not cached or has expired. newClient also returns when its client
+// expires, or the zero time if it only expires with the TTL of the pool.
+func (p *ClientPool) get(namespace, sa string, newClient func() (dynamic.Interface, time.Time, error)) (dynamic.Interface, error) {
+	if p == nil {
+		client, _, err := newClient()
+		return client, err
+	}
+	key := types.NamespacedName{Namespace: namespace, Name: sa}
+	now := p.now()
+	p.mu.Lock()
+	cached, ok := p.clients[key]
+	p.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.client, nil
+	}
+
+	// The client is created without holding the lock, as it may call the API server
+	client, expires, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	if maxExpires := now.Add(p.ttl); expires.IsZero() || expires.After(maxExpires) {
+		expires = maxExpires
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// Drop the expired clients, such as those of deleted service accounts
+	for k, c := range p.clients {
+		if !now.Before(c.expires) {
+			delete(p.clients, k)
+		}
+	}
+	p.clients[key] = pooledClient{client: client, expires: expires}
+	return client, nil
+}
+
+// DefaultAuthOverride impersonates the service accounts with the credentials
+// of the EventListener. The discovery client of the EventListener is shared by
+// all the service accounts, as the discovery results do not depend on them.
 type DefaultAuthOverride struct {
+	// Clients caches the impersonated clients. They are created for every
+	// call if it is nil.
+	Clients *ClientPool
 }
 
 func (r DefaultAuthOverride) OverrideAuthentication(sa string,
@@ -50,27 +145,77 @@ func (r DefaultAuthOverride) OverrideAuthentication(sa string,
 	defaultDynamicClient dynamic.Interface) (discoveryClient discoveryclient.ServerResourcesInterface,
 	dynamicClient dynamic.Interface,
 	err error) {
-	dynamicClient = defaultDynamicClient
-	discoveryClient = defaultDiscoverClient
-	clusterConfig, err := rest.InClusterConfig()
+	dynamicClient, err = r.Clients.get(namespace, sa, func() (dynamic.Interface, time.Time, error) {
+		clusterConfig, err := inClusterConfig()
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("problem getting in cluster config: %w", err)
+		}
+		clusterConfig.Impersonate = rest.ImpersonationConfig{
+			UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, sa),
+		}
+		client, err := dynamic.NewForConfig(clusterConfig)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("problem getting dynamic client set: %w", err)
+		}
+		return client, time.Time{}, nil
+	})
 	if err != nil {
-		log.Errorf("overrideAuthentication: problem getting in cluster config: %#v\n", err)
-		return
+		log.Errorf("overrideAuthentication: %v", err)
+		return defaultDiscoverClient, defaultDynamicClient, err
 	}
-	clusterConfig.Impersonate = rest.ImpersonationConfig{
-		UserName: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, sa),
-	}
-	dynamicClient, err = dynamic.NewForConfig(clusterConfig)
-	if err != nil {
-		log.Errorf("overrideAuthentication: problem getting dynamic client set: %#v\n", err)
-		return
-	}
-	kubeClient, err := kubernetes.NewForConfig(clusterConfig)
+	return defaultDiscoverClient, dynamicClient, nil
+}
+
+// TokenRequestAuthOverride authenticates as the service accounts with
+// short-lived tokens requested with the TokenRequest API, for the clusters
+// that do not allow the EventListener to impersonate them. The EventListener
+// must be allowed to create serviceaccounts/token.
+type TokenRequestAuthOverride struct {
+	KubeClientSet kubernetes.Interface
+	// ExpirationSeconds is the requested lifetime of the tokens. Defaults to
+	// DefaultTokenExpirationSeconds.
+	ExpirationSeconds int64
+	// Clients caches the clients until their token is about to expire. They
+	// are created for every call if it is nil.
+	Clients *ClientPool
+}
+
+func (r TokenRequestAuthOverride) OverrideAuthentication(sa string,
+	namespace string,
+	log *zap.SugaredLogger,
+	defaultDiscoverClient discoveryclient.ServerResourcesInterface,
+	defaultDynamicClient dynamic.Interface) (discoveryClient discoveryclient.ServerResourcesInterface,
+	dynamicClient dynamic.Interface,
+	err error) {
+	dynamicClient, err = r.Clients.get(namespace, sa, func() (dynamic.Interface, time.Time, error) {
+		expirationSeconds := r.ExpirationSeconds
+		if expirationSeconds == 0 {
+			expirationSeconds = DefaultTokenExpirationSeconds
+		}
+		requested := time.Now()
+		tr, err := r.KubeClientSet.CoreV1().ServiceAccounts(namespace).CreateToken(context.Background(), sa, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("problem requesting a token for service account %s/%s: %w", namespace, sa, err)
+		}
+		clusterConfig, err := inClusterConfig()
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("problem getting in cluster config: %w", err)
+		}
+		clusterConfig.BearerToken = tr.Status.Token
+		clusterConfig.BearerTokenFile = ""
+		client, err := dynamic.NewForConfig(clusterConfig)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("problem getting dynamic client set: %w", err)
+		}
+		// Renew the client when 80% of the lifetime of its token has elapsed
+		lifetime := tr.Status.ExpirationTimestamp.Sub(requested)
+		return client, requested.Add(lifetime * 4 / 5), nil
+	})
 	if err != nil {
-		log.Errorf("overrideAuthentication: problem getting kube client: %#v\n", err)
-		return
+		log.Errorf("overrideAuthentication: %v", err)
+		return defaultDiscoverClient, defaultDynamicClient, err
 	}
-	discoveryClient = kubeClient.Discovery()
-
-	return
+	return defaultDiscoverClient, dynamicClient, nil
 }
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	fakekubeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestClientPool(t *testing.T) {
+	now := time.Now()
+	p := NewClientPool(time.Minute)
+	p.now = func() time.Time { return now }
+	created := 0
+	newClient := func(expires time.Time) func() (dynamic.Interface, time.Time, error) {
+		return func() (dynamic.Interface, time.Time, error) {
+			created++
+			return fakedynamic.NewSimpleDynamicClient(runtime.NewScheme()), expires, nil
+		}
+	}
+
+	a, _ := p.get("ns", "a", newClient(time.Time{}))
+	if got, _ := p.get("ns", "a", newClient(time.Time{})); got != a || created != 1 {
+		t.Errorf("get() created %d clients, want the client of a to be cached", created)
+	}
+	if got, _ := p.get("other", "a", newClient(time.Time{})); got == a || created != 2 {
+		t.Errorf("get() created %d clients, want a client for each namespace", created)
+	}
+
+	// The clients expire with the TTL of the pool, or earlier if they say so
+	p.get("ns", "b", newClient(now.Add(10*time.Second)))
+	now = now.Add(30 * time.Second)
+	p.get("ns", "a", newClient(time.Time{}))
+	p.get("ns", "b", newClient(time.Time{}))
+	if created != 4 {
+		t.Errorf("get() created %d clients, want the client of b to be renewed", created)
+	}
+	now = now.Add(time.Minute)
+	p.get("ns", "a", newClient(time.Time{}))
+	if created != 5 {
+		t.Errorf("get() created %d clients, want the client of a to be renewed", created)
+	}
+	if _, ok := p.clients[types.NamespacedName{Namespace: "other", Name: "a"}]; ok {
+		t.Error("get() kept the expired client of other/a")
+	}
+
+	// Errors are not cached
+	if _, err := p.get("ns", "c", func() (dynamic.Interface, time.Time, error) {
+		return nil, time.Time{}, errors.New("failed")
+	}); err == nil {
+		t.Error("get() did not return the error creating the client")
+	}
+	if _, ok := p.clients[types.NamespacedName{Namespace: "ns", Name: "c"}]; ok {
+		t.Error("get() cached the client that could not be created")
+	}
+
+	var nilPool *ClientPool
+	nilPool.get("ns", "a", newClient(time.Time{}))
+	nilPool.get("ns", "a", newClient(time.Time{}))
+	if created != 7 {
+		t.Errorf("get() created %d clients, want a nil pool to create a client for every call", created)
+	}
+}
+
+func TestTokenRequestAuthOverride(t *testing.T) {
+	// The API server checks that the dynamic client uses the requested token
+	var gotAuthorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm"}}`))
+	}))
+	defer ts.Close()
+	defer func(f func() (*rest.Config, error)) { inClusterConfig = f }(inClusterConfig)
+	inClusterConfig = func() (*rest.Config, error) {
+		return &rest.Config{Host: ts.URL, BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token"}, nil
+	}
+
+	kubeClient := fakekubeclient.NewSimpleClientset()
+	requests := 0
+	kubeClient.PrependReactor("create", "serviceaccounts", func(action ktesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		requests++
+		tr := action.(ktesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		if got := *tr.Spec.ExpirationSeconds; got != 600 {
+			t.Errorf("requested token expiration = %d, want 600", got)
+		}
+		tr.Status = authenticationv1.TokenRequestStatus{
+			Token:               "sa-token",
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(10 * time.Minute)),
+		}
+		return true, tr, nil
+	})
+
+	auth := TokenRequestAuthOverride{
+		KubeClientSet:     kubeClient,
+		ExpirationSeconds: 600,
+		Clients:           NewClientPool(DefaultClientTTL),
+	}
+	defaultDiscovery := &fakediscovery.FakeDiscovery{}
+	for range 2 {
+		discovery, dynamicClient, err := auth.OverrideAuthentication("sa", "ns", zaptest.NewLogger(t).Sugar(), defaultDiscovery, nil)
+		if err != nil {
+			t.Fatalf("OverrideAuthentication() returned unexpected error: %v", err)
+		}
+		if discovery != defaultDiscovery {
+			t.Error("OverrideAuthentication() did not share the default discovery client")
+		}
+		if _, err := dynamicClient.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("ns").Get(context.Background(), "cm", metav1.GetOptions{}); err != nil {
+			t.Fatalf("error getting resource: %v", err)
+		}
+		if gotAuthorization != "Bearer sa-token" {
+			t.Errorf("got Authorization header %q, want the requested token", gotAuthorization)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("requested %d tokens, want the client to be cached", requests)
+	}
+
+	kubeClient.PrependReactor("create", "serviceaccounts", func(ktesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("forbidden")
+	})
+	if _, _, err := auth.OverrideAuthentication("other", "ns", zaptest.NewLogger(t).Sugar(), defaultDiscovery, nil); err == nil {
+		t.Error("OverrideAuthentication() did not return the error requesting a token")
+	}
+}
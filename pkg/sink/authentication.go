@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	unauthenticatedTag = "unauthenticated"
+	forbiddenTag       = "forbidden"
+	throttledTag       = "throttled"
+
+	// DefaultTokenReviewTTL is how long a TokenReviewCache keeps the result
+	// of the review of a token, whether it was authenticated or not.
+	DefaultTokenReviewTTL = 10 * time.Second
+	// DefaultTokenReviewQPS and DefaultTokenReviewBurst limit the rate of the
+	// reviews of the tokens a TokenReviewCache does not hold.
+	DefaultTokenReviewQPS   = 10
+	DefaultTokenReviewBurst = 20
+)
+
+var (
+	// errUnauthenticated is returned when no method authenticates a request.
+	errUnauthenticated = errors.New("request is not authenticated")
+	// errForbidden is returned when the user authenticated by the TokenReview
+	// API is not allowed to send requests.
+	errForbidden = errors.New("user is not allowed to send requests")
+	// errTooManyReviews is returned when a token is not reviewed because too
+	// many unknown tokens were reviewed recently.
+	errTooManyReviews = errors.New("too many bearer tokens to review")
+)
+
+// Authentication configures how the requests received by the EventListener are
+// authenticated. A request is accepted when any of the methods authenticates it.
+type Authentication struct {
+	// Tokens are the bearer tokens or API keys accepted.
+	Tokens []string
+	// APIKeyHeader is the header holding an API key, in addition to the
+	// bearer token of the Authorization header.
+	APIKeyHeader string
+	// ClientCertificates accepts the requests with a client certificate
+	// verified by the TLS server.
+	ClientCertificates bool
+	// TokenReview authenticates Kubernetes bearer tokens with the TokenReview API.
+	TokenReview bool
+	// TokenReviewAudiences are the audiences the Kubernetes bearer tokens must
+	// be issued for.
+	TokenReviewAudiences []string
+	// TokenReviewUsers are the users allowed to send requests with Kubernetes
+	// bearer tokens. All users are allowed if empty.
+	TokenReviewUsers []string
+	// TokenReviews caches the results of the TokenReview API. The tokens are
+	// reviewed for every request if it is nil.
+	TokenReviews *TokenReviewCache
+}
+
+// TokenReviewCache caches the results of the TokenReview API, keyed by the
+// hash of the tokens, so that a token is not reviewed for every request. It
+// also limits the rate of the reviews of the tokens it does not hold, so that
+// requests with random tokens do not flood the API server.
+type TokenReviewCache struct {
+	ttl     time.Duration
+	limiter flowcontrol.RateLimiter
+	// now is replaced in tests
+	now func() time.Time
+
+	mu      sync.Mutex
+	reviews map[[sha256.Size]byte]cachedTokenReview
+}
+
+type cachedTokenReview struct {
+	status  authenticationv1.TokenReviewStatus
+	expires time.Time
+}
+
+// NewTokenReviewCache returns a TokenReviewCache keeping the result of the
+// review of each token for ttl, which reviews the tokens it does not hold at
+// qps reviews per second, with bursts of burst reviews.
+func NewTokenReviewCache(ttl time.Duration, qps float32, burst int) *TokenReviewCache {
+	return &TokenReviewCache{
+		ttl:     ttl,
+		limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+		now:     time.Now,
+		reviews: map[[sha256.Size]byte]cachedTokenReview{},
+	}
+}
+
+// get returns the status of the review of the token, reviewed with review if
+// it is not cached or has expired. The errors of review are not cached.
+func (c *TokenReviewCache) get(token string, review func() (authenticationv1.TokenReviewStatus, error)) (authenticationv1.TokenReviewStatus, error) {
+	if c == nil {
+		return review()
+	}
+	key := sha256.Sum256([]byte(token))
+	now := c.now()
+	c.mu.Lock()
+	cached, ok := c.reviews[key]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.status, nil
+	}
+	if !c.limiter.TryAccept() {
+		return authenticationv1.TokenReviewStatus{}, errTooManyReviews
+	}
+
+	// The token is reviewed without holding the lock, as it calls the API server
+	status, err := review()
+	if err != nil {
+		return status, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Drop the expired reviews, such as those of the tokens no longer sent
+	for k, r := range c.reviews {
+		if !now.Before(r.expires) {
+			delete(c.reviews, k)
+		}
+	}
+	c.reviews[key] = cachedTokenReview{status: status, expires: now.Add(c.ttl)}
+	return status, nil
+}
+
+// Authenticate rejects the requests that are not authenticated by the
+// Authentication, before their body is read. It returns eventHandler as is if
+// the Authentication is nil.
+func (r Sink) Authenticate(eventHandler http.Handler) http.Handler {
+	if r.Authentication == nil {
+		return eventHandler
+	}
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		err := r.authenticate(request)
+		switch {
+		case err == nil:
+			eventHandler.ServeHTTP(response, request)
+		case errors.Is(err, errForbidden):
+			r.rejectRequest(response, http.StatusForbidden, forbiddenTag, err)
+		case errors.Is(err, errUnauthenticated):
+			response.Header().Set("WWW-Authenticate", `Bearer realm="eventlistener"`)
+			r.rejectRequest(response, http.StatusUnauthorized, unauthenticatedTag, err)
+		case errors.Is(err, errTooManyReviews):
+			response.Header().Set("Retry-After", "1")
+			r.rejectRequest(response, http.StatusTooManyRequests, throttledTag, err)
+		default:
+			r.Logger.Errorf("failed to authenticate request: %s", err)
+			r.rejectRequest(response, http.StatusInternalServerError, failTag, errors.New("failed to authenticate the request"))
+		}
+	})
+}
+
+// authenticate returns nil if the request is authenticated, errUnauthenticated
+// or errForbidden if it is not, or the error that occurred authenticating it.
+func (r Sink) authenticate(request *http.Request) error {
+	a := r.Authentication
+	bearer, hasBearer := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+	for _, token := range a.Tokens {
+		// An empty token would accept the requests without credentials
+		if token == "" {
+			continue
+		}
+		if hasBearer && subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) == 1 {
+			return nil
+		}
+		if a.APIKeyHeader != "" {
+			if key := request.Header.Get(a.APIKeyHeader); key != "" && subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1 {
+				return nil
+			}
+		}
+	}
+	if a.ClientCertificates && request.TLS != nil && len(request.TLS.VerifiedChains) > 0 {
+		return nil
+	}
+	if a.TokenReview && hasBearer && bearer != "" {
+		return r.reviewToken(request, bearer)
+	}
+	return errUnauthenticated
+}
+
+// reviewToken authenticates a Kubernetes bearer token with the TokenReview
+// API, or with the cached result of its previous review.
+func (r Sink) reviewToken(request *http.Request, token string) error {
+	status, err := r.Authentication.TokenReviews.get(token, func() (authenticationv1.TokenReviewStatus, error) {
+		review, err := r.KubeClientSet.AuthenticationV1().TokenReviews().Create(request.Context(), &authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{
+				Token:     token,
+				Audiences: r.Authentication.TokenReviewAudiences,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return authenticationv1.TokenReviewStatus{}, fmt.Errorf("failed to review the bearer token: %w", err)
+		}
+		return review.Status, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !status.Authenticated {
+		return errUnauthenticated
+	}
+	if users := r.Authentication.TokenReviewUsers; len(users) > 0 && !slices.Contains(users, status.User.Username) {
+		return fmt.Errorf("%w: %s", errForbidden, status.User.Username)
+	}
+	return nil
+}
+
+// rejectRequest responds to a request that is not processed, and counts it
+// in the metrics with the status tag.
+func (r Sink) rejectRequest(response http.ResponseWriter, status int, tag string, err error) {
+	r.recordCountMetrics(tag)
+	r.Logger.Infof("rejecting request: %s", err)
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if err := json.NewEncoder(response).Encode(Response{
+		EventListener: r.EventListenerName,
+		Namespace:     r.EventListenerNamespace,
+		ErrorMessage:  err.Error(),
+	}); err != nil {
+		r.Logger.Errorf("failed to write back sink response: %v", err)
+	}
+}
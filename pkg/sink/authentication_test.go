@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap/zaptest"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakekubeclient "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestAuthenticate(t *testing.T) {
+	tokenReview := func(authenticated bool, user string, err error) ktesting.ReactionFunc {
+		return func(action ktesting.Action) (bool, runtime.Object, error) {
+			review := action.(ktesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			if review.Spec.Token != "kube-token" {
+				return true, nil, errors.New("unexpected token")
+			}
+			if diff := cmp.Diff([]string{"eventlistener"}, review.Spec.Audiences); diff != "" {
+				return true, nil, errors.New("unexpected audiences: " + diff)
+			}
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: authenticated,
+				User:          authenticationv1.UserInfo{Username: user},
+			}
+			return true, review, err
+		}
+	}
+	verifiedTLS := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{}}}
+
+	tests := []struct {
+		name           string
+		authentication *Authentication
+		headers        map[string]string
+		tls            *tls.ConnectionState
+		reactor        ktesting.ReactionFunc
+		wantStatusCode int
+	}{{
+		name:           "no authentication",
+		wantStatusCode: http.StatusOK,
+	}, {
+		name:           "no credentials",
+		authentication: &Authentication{Tokens: []string{"token"}},
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "bearer token",
+		authentication: &Authentication{Tokens: []string{"other", "token"}},
+		headers:        map[string]string{"Authorization": "Bearer token"},
+		wantStatusCode: http.StatusOK,
+	}, {
+		name:           "wrong bearer token",
+		authentication: &Authentication{Tokens: []string{"token"}},
+		headers:        map[string]string{"Authorization": "Bearer other"},
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "empty token",
+		authentication: &Authentication{Tokens: []string{""}, APIKeyHeader: "X-Api-Key"},
+		headers:        map[string]string{"Authorization": "Bearer ", "X-Api-Key": ""},
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "api key",
+		authentication: &Authentication{Tokens: []string{"token"}, APIKeyHeader: "X-Api-Key"},
+		headers:        map[string]string{"X-Api-Key": "token"},
+		wantStatusCode: http.StatusOK,
+	}, {
+		name:           "api key without header configured",
+		authentication: &Authentication{Tokens: []string{"token"}},
+		headers:        map[string]string{"X-Api-Key": "token"},
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "verified client certificate",
+		authentication: &Authentication{ClientCertificates: true},
+		tls:            verifiedTLS,
+		wantStatusCode: http.StatusOK,
+	}, {
+		name:           "unverified client certificate",
+		authentication: &Authentication{ClientCertificates: true},
+		tls:            &tls.ConnectionState{},
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "client certificate not accepted",
+		authentication: &Authentication{Tokens: []string{"token"}},
+		tls:            verifiedTLS,
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "token review",
+		authentication: &Authentication{TokenReview: true, TokenReviewAudiences: []string{"eventlistener"}, TokenReviewUsers: []string{"system:serviceaccount:ns:sender"}},
+		headers:        map[string]string{"Authorization": "Bearer kube-token"},
+		reactor:        tokenReview(true, "system:serviceaccount:ns:sender", nil),
+		wantStatusCode: http.StatusOK,
+	}, {
+		name:           "token review not authenticated",
+		authentication: &Authentication{TokenReview: true, TokenReviewAudiences: []string{"eventlistener"}},
+		headers:        map[string]string{"Authorization": "Bearer kube-token"},
+		reactor:        tokenReview(false, "", nil),
+		wantStatusCode: http.StatusUnauthorized,
+	}, {
+		name:           "token review user not allowed",
+		authentication: &Authentication{TokenReview: true, TokenReviewAudiences: []string{"eventlistener"}, TokenReviewUsers: []string{"system:serviceaccount:ns:sender"}},
+		headers:        map[string]string{"Authorization": "Bearer kube-token"},
+		reactor:        tokenReview(true, "system:serviceaccount:ns:other", nil),
+		wantStatusCode: http.StatusForbidden,
+	}, {
+		name:           "token review error",
+		authentication: &Authentication{TokenReview: true, TokenReviewAudiences: []string{"eventlistener"}},
+		headers:        map[string]string{"Authorization": "Bearer kube-token"},
+		reactor:        tokenReview(false, "", errors.New("apiserver unavailable")),
+		wantStatusCode: http.StatusInternalServerError,
+	}}
+
+	recorder, err := NewRecorder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeClient := fakekubeclient.NewSimpleClientset()
+			if tt.reactor != nil {
+				kubeClient.PrependReactor("create", "tokenreviews", tt.reactor)
+			}
+			r := Sink{
+				KubeClientSet:  kubeClient,
+				Logger:         zaptest.NewLogger(t).Sugar(),
+				Recorder:       recorder,
+				Authentication: tt.authentication,
+			}
+			handled := false
+			handler := r.Authenticate(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				handled = true
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			req.TLS = tt.tls
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatusCode {
+				t.Fatalf("Authenticate() status code = %d, want %d: %s", rec.Code, tt.wantStatusCode, rec.Body)
+			}
+			if handled != (tt.wantStatusCode == http.StatusOK) {
+				t.Errorf("Authenticate() handled the request = %t, want %t", handled, !handled)
+			}
+			if got := rec.Header().Get("WWW-Authenticate"); (got != "") != (tt.wantStatusCode == http.StatusUnauthorized) {
+				t.Errorf("Authenticate() WWW-Authenticate header = %q", got)
+			}
+		})
+	}
+}
+
+func TestTokenReviewCache(t *testing.T) {
+	reviews := map[string]int{}
+	kubeClient := fakekubeclient.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "tokenreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+		review := action.(ktesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		reviews[review.Spec.Token]++
+		review.Status = authenticationv1.TokenReviewStatus{
+			Authenticated: review.Spec.Token == "kube-token",
+			User:          authenticationv1.UserInfo{Username: "system:serviceaccount:ns:sender"},
+		}
+		return true, review, nil
+	})
+	recorder, err := NewRecorder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	cache := NewTokenReviewCache(time.Minute, 0.001, 3)
+	cache.now = func() time.Time { return now }
+	r := Sink{
+		KubeClientSet:  kubeClient,
+		Logger:         zaptest.NewLogger(t).Sugar(),
+		Recorder:       recorder,
+		Authentication: &Authentication{TokenReview: true, TokenReviews: cache},
+	}
+	handler := r.Authenticate(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	send := func(token string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Both the authenticated and the denied tokens are cached
+	for range 3 {
+		if got := send("kube-token"); got != http.StatusOK {
+			t.Errorf("got status code %d for the authenticated token, want %d", got, http.StatusOK)
+		}
+		if got := send("other-token"); got != http.StatusUnauthorized {
+			t.Errorf("got status code %d for the denied token, want %d", got, http.StatusUnauthorized)
+		}
+	}
+	if diff := cmp.Diff(map[string]int{"kube-token": 1, "other-token": 1}, reviews); diff != "" {
+		t.Errorf("reviews of the tokens (-want, +got): %s", diff)
+	}
+
+	// The reviews are cached until they expire
+	now = now.Add(time.Minute)
+	if got := send("kube-token"); got != http.StatusOK {
+		t.Errorf("got status code %d for the authenticated token, want %d", got, http.StatusOK)
+	}
+	if reviews["kube-token"] != 2 {
+		t.Errorf("got %d reviews of the expired token, want 2", reviews["kube-token"])
+	}
+
+	// The reviews of unknown tokens are rate-limited, but not the cached ones
+	if got := send("random-token"); got != http.StatusTooManyRequests {
+		t.Errorf("got status code %d for the unknown token, want %d", got, http.StatusTooManyRequests)
+	}
+	if _, ok := reviews["random-token"]; ok {
+		t.Error("the unknown token should not be reviewed")
+	}
+	if got := send("kube-token"); got != http.StatusOK {
+		t.Errorf("got status code %d for the cached token, want %d", got, http.StatusOK)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type rawBodyKey struct{}
+
+// rawBody is the body of a request as it was received, and its Content-Encoding.
+type rawBody struct {
+	body     []byte
+	encoding string
+}
+
+// withRawBody records the body of a request as it was received, before its
+// decompression, and its Content-Encoding.
+func withRawBody(ctx context.Context, body []byte, encoding string) context.Context {
+	return context.WithValue(ctx, rawBodyKey{}, rawBody{body: body, encoding: encoding})
+}
+
+// rawBodyFromContext returns the body of a request as it was received, or nil
+// if the body was not compressed.
+func rawBodyFromContext(ctx context.Context) []byte {
+	raw, _ := ctx.Value(rawBodyKey{}).(rawBody)
+	return raw.body
+}
+
+// receivedRequest returns the header and body of the request as they were
+// received, before DecodeBody decompressed the body.
+func receivedRequest(request *http.Request, body []byte) (http.Header, []byte) {
+	header := request.Header.Clone()
+	raw, ok := request.Context().Value(rawBodyKey{}).(rawBody)
+	if !ok {
+		return header, body
+	}
+	header.Set("Content-Encoding", raw.encoding)
+	header.Set("Content-Length", strconv.Itoa(len(raw.body)))
+	return header, raw.body
+}
+
+// errBodyTooLarge is returned when a body exceeds the MaxBodySize.
+var errBodyTooLarge = errors.New("request body too large")
+
+// DecodeBody limits the size of the body of requests to the MaxBodySize, and
+// decompresses the bodies with a gzip or deflate Content-Encoding. The body as
+// it was received is passed to the interceptors, so that they can verify
+// signatures computed over the compressed body.
+func (r Sink) DecodeBody(eventHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		body := request.Body
+		if r.MaxBodySize > 0 {
+			body = http.MaxBytesReader(response, request.Body, r.MaxBodySize)
+		}
+		raw, err := io.ReadAll(body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				r.rejectBody(response, http.StatusRequestEntityTooLarge, errBodyTooLarge)
+				return
+			}
+			r.recordCountMetrics(failTag)
+			r.Logger.Errorf("Error reading event body: %s", err)
+			response.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		encoding := strings.ToLower(strings.TrimSpace(request.Header.Get("Content-Encoding")))
+		if encoding == "" || encoding == "identity" {
+			request.Body = io.NopCloser(bytes.NewReader(raw))
+			eventHandler.ServeHTTP(response, request)
+			return
+		}
+		decoded, err := r.decompress(encoding, raw)
+		switch {
+		case errors.Is(err, errBodyTooLarge):
+			r.rejectBody(response, http.StatusRequestEntityTooLarge, err)
+			return
+		case errors.Is(err, errors.ErrUnsupported):
+			r.rejectBody(response, http.StatusUnsupportedMediaType, err)
+			return
+		case err != nil:
+			r.rejectBody(response, http.StatusBadRequest, err)
+			return
+		}
+		request = request.WithContext(withRawBody(request.Context(), raw, request.Header.Get("Content-Encoding")))
+		request.Header.Del("Content-Encoding")
+		request.Header.Set("Content-Length", strconv.Itoa(len(decoded)))
+		request.ContentLength = int64(len(decoded))
+		request.Body = io.NopCloser(bytes.NewReader(decoded))
+		eventHandler.ServeHTTP(response, request)
+	})
+}
+
+// decompress decompresses a body with a gzip or deflate Content-Encoding,
+// without exceeding the MaxBodySize.
+func (r Sink) decompress(encoding string, body []byte) ([]byte, error) {
+	var reader io.Reader
+	switch encoding {
+	case "gzip", "x-gzip":
+		gz, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress the body: %w", err)
+		}
+		defer gz.Close()
+		reader = gz
+	case "deflate":
+		// deflate is zlib-wrapped per RFC 9110 but some senders send raw
+		// deflate data
+		zr, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			fr := flate.NewReader(bytes.NewReader(body))
+			defer fr.Close()
+			reader = fr
+		} else {
+			defer zr.Close()
+			reader = zr
+		}
+	default:
+		return nil, fmt.Errorf("content encoding %q: %w", encoding, errors.ErrUnsupported)
+	}
+	if r.MaxBodySize > 0 {
+		reader = io.LimitReader(reader, r.MaxBodySize+1)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress the body: %w", err)
+	}
+	if r.MaxBodySize > 0 && int64(len(decoded)) > r.MaxBodySize {
+		return nil, errBodyTooLarge
+	}
+	return decoded, nil
+}
+
+// rejectBody responds to a request whose body cannot be processed.
+func (r Sink) rejectBody(response http.ResponseWriter, status int, err error) {
+	errMsg := fmt.Sprintf("Invalid event body: %s", err)
+	r.recordCountMetrics(failTag)
+	r.Logger.Error(errMsg)
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if err := json.NewEncoder(response).Encode(Response{
+		EventListener: r.EventListenerName,
+		Namespace:     r.EventListenerNamespace,
+		ErrorMessage:  errMsg,
+	}); err != nil {
+		r.Logger.Errorf("failed to write back sink response: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap/zaptest"
+)
+
+func compress(t *testing.T, encoding string, body []byte) []byte {
+	t.Helper()
+	var b bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&b)
+	case "deflate":
+		w = zlib.NewWriter(&b)
+	case "raw deflate":
+		w, _ = flate.NewWriter(&b, flate.DefaultCompression)
+	}
+	if _, err := w.Write(body); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestSink_DecodeBody(t *testing.T) {
+	body := []byte(`{"ref": "main"}`)
+	gzipped := compress(t, "gzip", body)
+	large := bytes.Repeat([]byte(" "), 1024)
+
+	tests := []struct {
+		name            string
+		maxBodySize     int64
+		body            []byte
+		contentEncoding string
+		wantStatusCode  int
+		wantBody        []byte
+		wantRawBody     []byte
+	}{{
+		name:           "uncompressed body",
+		body:           body,
+		wantStatusCode: http.StatusOK,
+		wantBody:       body,
+	}, {
+		name:            "gzip body",
+		body:            gzipped,
+		contentEncoding: "gzip",
+		wantStatusCode:  http.StatusOK,
+		wantBody:        body,
+		wantRawBody:     gzipped,
+	}, {
+		name:            "deflate body",
+		body:            compress(t, "deflate", body),
+		contentEncoding: "deflate",
+		wantStatusCode:  http.StatusOK,
+		wantBody:        body,
+		wantRawBody:     compress(t, "deflate", body),
+	}, {
+		name:            "raw deflate body",
+		body:            compress(t, "raw deflate", body),
+		contentEncoding: "Deflate",
+		wantStatusCode:  http.StatusOK,
+		wantBody:        body,
+		wantRawBody:     compress(t, "raw deflate", body),
+	}, {
+		name:           "body under the max body size",
+		maxBodySize:    int64(len(body)),
+		body:           body,
+		wantStatusCode: http.StatusOK,
+		wantBody:       body,
+	}, {
+		name:           "body over the max body size",
+		maxBodySize:    int64(len(body)) - 1,
+		body:           body,
+		wantStatusCode: http.StatusRequestEntityTooLarge,
+	}, {
+		name:            "decompressed body over the max body size",
+		maxBodySize:     512,
+		body:            compress(t, "gzip", large),
+		contentEncoding: "gzip",
+		wantStatusCode:  http.StatusRequestEntityTooLarge,
+	}, {
+		name:            "invalid gzip body",
+		body:            body,
+		contentEncoding: "gzip",
+		wantStatusCode:  http.StatusBadRequest,
+	}, {
+		name:            "unsupported encoding",
+		body:            body,
+		contentEncoding: "br",
+		wantStatusCode:  http.StatusUnsupportedMediaType,
+	}}
+
+	recorder, err := NewRecorder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Sink{
+				Logger:      zaptest.NewLogger(t).Sugar(),
+				Recorder:    recorder,
+				MaxBodySize: tt.maxBodySize,
+			}
+			var gotBody, gotRawBody []byte
+			var gotEncoding string
+			ts := httptest.NewServer(r.DecodeBody(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+				gotBody, _ = io.ReadAll(request.Body)
+				gotRawBody = rawBodyFromContext(request.Context())
+				gotEncoding = request.Header.Get("Content-Encoding")
+			})))
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error making request: %s", err)
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != tt.wantStatusCode {
+				respBody, _ := io.ReadAll(resp.Body)
+				t.Fatalf("DecodeBody() status code = %d, want %d: %s", resp.StatusCode, tt.wantStatusCode, respBody)
+			}
+			if diff := cmp.Diff(string(tt.wantBody), string(gotBody)); diff != "" {
+				t.Errorf("DecodeBody() body (-want,+got): %s", diff)
+			}
+			if !bytes.Equal(tt.wantRawBody, gotRawBody) {
+				t.Errorf("DecodeBody() raw body = %q, want %q", gotRawBody, tt.wantRawBody)
+			}
+			if gotEncoding != "" {
+				t.Errorf("DecodeBody() Content-Encoding = %q, want it removed", gotEncoding)
+			}
+		})
+	}
+}
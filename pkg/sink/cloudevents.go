@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.uber.org/zap"
+)
+
+// BatchResponse defines the HTTP body that the Sink responds to batches of
+// CloudEvents with.
+type BatchResponse struct {
+	// EventListener is the name of the eventListener.
+	EventListener string `json:"eventListener"`
+	// Namespace is the namespace that the eventListener is running in.
+	Namespace string `json:"namespace,omitempty"`
+	// Events lists the outcome of each CloudEvent of the batch, in order.
+	Events []BatchEventResult `json:"events"`
+}
+
+// BatchEventResult is the outcome of a CloudEvent of a batch, which is
+// processed like a CloudEvent received on its own.
+type BatchEventResult struct {
+	// ID is the id attribute of the CloudEvent.
+	ID string `json:"id"`
+	// EventID is the uniqueID assigned to the CloudEvent.
+	EventID string `json:"eventID,omitempty"`
+	// Status is the HTTP status the CloudEvent would have been responded to
+	// with on its own.
+	Status int `json:"status"`
+	// Accepted is true if the EventListener accepted the CloudEvent.
+	Accepted bool `json:"accepted"`
+	// ErrorMessage gives message about Error which occurs during event processing
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Triggers lists the outcome of each Trigger evaluated for the CloudEvent.
+	// It is only set when a synchronous response is requested.
+	Triggers []TriggerResult `json:"triggers,omitempty"`
+	// Duplicate is true if the CloudEvent is a repeated delivery that was
+	// skipped.
+	Duplicate bool `json:"duplicate,omitempty"`
+}
+
+// isCloudEventBatch returns true if the request is a batch of CloudEvents.
+func isCloudEventBatch(request *http.Request) bool {
+	return cehttp.NewMessage(request.Header, nil).ReadEncoding() == binding.EncodingBatch
+}
+
+// handleCloudEventBatch processes each CloudEvent of a batch like a CloudEvent
+// received on its own, with its own eventID, and responds with the outcome of
+// each. The status of the response is the highest status of the CloudEvents,
+// so that a batch is retried if any of its CloudEvents was not accepted.
+func (r Sink) handleCloudEventBatch(response http.ResponseWriter, request *http.Request) {
+	log := r.Logger.With(
+		zap.String("eventlistener", r.EventListenerName),
+		zap.String("namespace", r.EventListenerNamespace),
+	)
+	events, err := binding.ToEvents(request.Context(), cehttp.NewMessageFromHttpRequest(request), request.Body)
+	if err != nil {
+		log.Errorf("Error reading batch of CloudEvents: %s", err)
+		r.recordCountMetrics(failTag)
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	body := BatchResponse{
+		EventListener: r.EventListenerName,
+		Namespace:     r.EventListenerNamespace,
+		Events:        make([]BatchEventResult, 0, len(events)),
+	}
+	status := http.StatusAccepted
+	// HandleEvent fails each CloudEvent if the EventListener cannot be found
+	el, _ := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if sync, _ := r.syncResponse(el, request); sync {
+		status = http.StatusOK
+	}
+	for _, e := range events {
+		single, data, err := cloudEventRequest(request, e)
+		if err == nil && r.PayloadValidation {
+			var payload map[string]interface{}
+			if err = json.Unmarshal(data, &payload); err != nil {
+				err = fmt.Errorf("invalid event body format: %w", err)
+			}
+		}
+		if err != nil {
+			log.Errorf("Error reading CloudEvent %s of the batch: %s", e.ID(), err)
+			r.recordCountMetrics(failTag)
+			body.Events = append(body.Events, BatchEventResult{ID: e.ID(), Status: http.StatusBadRequest, ErrorMessage: err.Error()})
+			status = max(status, http.StatusBadRequest)
+			continue
+		}
+
+		recorder := &bufferedResponseWriter{discardResponseWriter: discardResponseWriter{header: http.Header{}}}
+		r.HandleEvent(recorder, single)
+		result := BatchEventResult{
+			ID:       e.ID(),
+			Status:   recorder.status,
+			Accepted: recorder.status < http.StatusBadRequest,
+		}
+		var res Response
+		if err := json.Unmarshal(recorder.body.Bytes(), &res); err == nil {
+			result.EventID = res.EventID
+			result.ErrorMessage = res.ErrorMessage
+			result.Triggers = res.Triggers
+			result.Duplicate = res.Duplicate
+		}
+		if !result.Accepted {
+			status = max(status, recorder.status)
+		}
+		body.Events = append(body.Events, result)
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if err := json.NewEncoder(response).Encode(body); err != nil {
+		log.Errorf("failed to write back sink response: %v", err)
+	}
+}
+
+// unwrapCloudEvent returns the request and body of a CloudEvent sent in
+// structured mode as if it was sent in binary mode: its data is the body, and
+// its attributes are the ce-* headers. Other requests are returned unchanged.
+func unwrapCloudEvent(request *http.Request, body []byte) (*http.Request, []byte, error) {
+	msg := cehttp.NewMessage(request.Header, io.NopCloser(bytes.NewReader(body)))
+	if msg.ReadEncoding() != binding.EncodingStructured {
+		return request, body, nil
+	}
+	e, err := binding.ToEvent(request.Context(), msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cloudEventRequest(request, *e)
+}
+
+// cloudEventRequest returns a copy of the request with the attributes of the
+// CloudEvent as ce-* headers and its data as body, and the data.
+func cloudEventRequest(request *http.Request, e event.Event) (*http.Request, []byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, nil, err
+	}
+	out := request.Clone(request.Context())
+	out.Header.Del("Content-Type")
+	attributes := &http.Request{Header: out.Header}
+	if err := cehttp.WriteRequest(request.Context(), binding.ToMessage(&e), attributes); err != nil {
+		return nil, nil, err
+	}
+	data := e.Data()
+	out.Body = io.NopCloser(bytes.NewReader(data))
+	out.ContentLength = int64(len(data))
+	return out, data, nil
+}
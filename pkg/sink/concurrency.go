@@ -0,0 +1,468 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/resources"
+	"github.com/tidwall/sjson"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+)
+
+// concurrencyPollInterval is how often a queued event checks whether the
+// resources of its concurrency group completed.
+var concurrencyPollInterval = 10 * time.Second
+
+// concurrencyLeaseDuration is how long the Lease of a concurrency group is held
+// by a replica before another replica can take it over, in case the replica
+// holding it stopped before releasing it.
+const concurrencyLeaseDuration = time.Minute
+
+// cancelledStatus is the spec.status cancelling each kind of resource that
+// concurrency groups apply to.
+var cancelledStatus = map[schema.GroupKind]string{
+	{Group: "tekton.dev", Kind: "PipelineRun"}: "Cancelled",
+	{Group: "tekton.dev", Kind: "TaskRun"}:     "TaskRunCancelled",
+}
+
+var (
+	errConcurrencyQueueFull    = errors.New("too many events are waiting for their turn in the concurrency group")
+	errConcurrencyQueueTimeout = errors.New("timed out waiting for a turn in the concurrency group")
+)
+
+// ConcurrencyGroups processes the events of the same concurrency group one at
+// a time and in order of arrival, so that two events of a group cannot both
+// find it idle. The events waiting for their turn, including the first one of
+// a group when it waits for the resources of the group to complete, are held
+// outside of the workers. A nil ConcurrencyGroups does not hold any event, and
+// fails the events that must wait.
+type ConcurrencyGroups struct {
+	mu     sync.Mutex
+	groups map[string]*concurrencyGroup
+	// abandoned is true once the EventListener shuts down
+	abandoned bool
+	// now is replaced in tests
+	now func() time.Time
+}
+
+type concurrencyGroup struct {
+	// busy is true while an event of the group is being processed
+	busy bool
+	// queue holds the events waiting for their turn, in order of arrival
+	queue []*queuedEvent
+	// retry processes the first queued event again, once it waited for the
+	// resources of the group for the poll interval
+	retry *time.Timer
+}
+
+// queuedEvent is an event processed in its turn in a concurrency group.
+type queuedEvent struct {
+	eventID string
+	// timeout is how long the event can wait for its turn
+	timeout  time.Duration
+	deadline time.Time
+	// process processes the event in its turn. It returns true if the event
+	// must wait for the resources of the group to complete.
+	process func() bool
+	// drop is called with the reason the event failed to get its turn
+	drop func(error)
+}
+
+// NewConcurrencyGroups returns an empty ConcurrencyGroups.
+func NewConcurrencyGroups() *ConcurrencyGroups {
+	return &ConcurrencyGroups{groups: map[string]*concurrencyGroup{}, now: time.Now}
+}
+
+// run processes the event in its turn in the group. It is processed right
+// away if the group is idle, and is otherwise queued, up to maxQueued events,
+// then processed by the function passed to resume.
+func (g *ConcurrencyGroups) run(key string, e *queuedEvent, maxQueued int, resume func(func())) {
+	if g == nil {
+		if e.process() {
+			e.drop(errConcurrencyQueueFull)
+		}
+		return
+	}
+	g.mu.Lock()
+	cg, ok := g.groups[key]
+	if !ok {
+		cg = &concurrencyGroup{}
+		g.groups[key] = cg
+	}
+	if cg.busy || len(cg.queue) > 0 {
+		var err error
+		switch {
+		case g.abandoned:
+			err = errAbandoned
+		case len(cg.queue) >= maxQueued:
+			err = errConcurrencyQueueFull
+		default:
+			e.deadline = g.now().Add(e.timeout)
+			cg.queue = append(cg.queue, e)
+		}
+		g.mu.Unlock()
+		if err != nil {
+			e.drop(err)
+		}
+		return
+	}
+	cg.busy = true
+	g.mu.Unlock()
+	g.process(key, cg, e, resume)
+}
+
+// process processes the event holding the group, then hands the group over to
+// the next event. An event that must wait stays first in the queue.
+func (g *ConcurrencyGroups) process(key string, cg *concurrencyGroup, e *queuedEvent, resume func(func())) {
+	wait := e.process()
+
+	g.mu.Lock()
+	cg.busy = false
+	var waiting, abandoned *queuedEvent
+	if wait {
+		if g.abandoned {
+			abandoned = e
+		} else {
+			if e.deadline.IsZero() {
+				e.deadline = g.now().Add(e.timeout)
+			}
+			cg.queue = append([]*queuedEvent{e}, cg.queue...)
+			waiting = e
+		}
+	}
+	next, expired := g.advance(key, cg, waiting, resume)
+	g.mu.Unlock()
+
+	if abandoned != nil {
+		abandoned.drop(errAbandoned)
+	}
+	g.start(key, cg, next, expired, resume)
+}
+
+// advance drops the queued events past their deadline, and returns the next
+// event to process, if any. If waiting is still the first queued event, it is
+// processed again after the poll interval instead. It must be called with the
+// lock held.
+func (g *ConcurrencyGroups) advance(key string, cg *concurrencyGroup, waiting *queuedEvent, resume func(func())) (*queuedEvent, []*queuedEvent) {
+	now := g.now()
+	var expired []*queuedEvent
+	queue := cg.queue[:0]
+	for _, e := range cg.queue {
+		if now.After(e.deadline) {
+			expired = append(expired, e)
+		} else {
+			queue = append(queue, e)
+		}
+	}
+	cg.queue = queue
+
+	switch {
+	case len(cg.queue) == 0:
+		// The group may have been replaced after it was abandoned
+		if g.groups[key] == cg {
+			delete(g.groups, key)
+		}
+		return nil, expired
+	case waiting != nil && cg.queue[0] == waiting:
+		if cg.retry == nil {
+			cg.retry = time.AfterFunc(concurrencyPollInterval, func() {
+				g.mu.Lock()
+				cg.retry = nil
+				if cg.busy {
+					g.mu.Unlock()
+					return
+				}
+				next, expired := g.advance(key, cg, nil, resume)
+				g.mu.Unlock()
+				g.start(key, cg, next, expired, resume)
+			})
+		}
+		return nil, expired
+	}
+	next := cg.queue[0]
+	cg.queue = cg.queue[1:]
+	cg.busy = true
+	return next, expired
+}
+
+// start fails the expired events, and processes the next event of the group
+// in a new worker.
+func (g *ConcurrencyGroups) start(key string, cg *concurrencyGroup, next *queuedEvent, expired []*queuedEvent, resume func(func())) {
+	for _, e := range expired {
+		e.drop(errConcurrencyQueueTimeout)
+	}
+	if next != nil {
+		resume(func() { g.process(key, cg, next, resume) })
+	}
+}
+
+// abandon fails the queued events, and from then on the events that must
+// wait, as the EventListener shuts down. It returns the IDs of the failed
+// events.
+func (g *ConcurrencyGroups) abandon() []string {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	g.abandoned = true
+	var queued []*queuedEvent
+	for key, cg := range g.groups {
+		if cg.retry != nil {
+			cg.retry.Stop()
+			cg.retry = nil
+		}
+		queued = append(queued, cg.queue...)
+		cg.queue = nil
+		if !cg.busy {
+			delete(g.groups, key)
+		}
+	}
+	g.mu.Unlock()
+
+	ids := make([]string, 0, len(queued))
+	for _, e := range queued {
+		e.drop(errAbandoned)
+		ids = append(ids, e.eventID)
+	}
+	return ids
+}
+
+// holdConcurrencyGroup acquires the Lease of the concurrency group of the
+// Trigger for the event, so that a single replica of the EventListener applies
+// the policy of the group and creates its resources at a time. It returns false
+// if another replica holds the Lease, and otherwise the function releasing it.
+func (r Sink) holdConcurrencyGroup(ctx context.Context, el *triggersv1.EventListener, t triggersv1.Trigger, group, eventID string) (bool, func(), error) {
+	leases := r.KubeClientSet.CoordinationV1().Leases(r.EventListenerNamespace)
+	sum := sha256.Sum256([]byte(r.EventListenerName + "/" + t.Namespace + "/" + t.Name + "/" + group))
+	name := "el-concurrency-" + hex.EncodeToString(sum[:])[:40]
+	now := metav1.NewMicroTime(time.Now())
+	spec := coordinationv1.LeaseSpec{
+		HolderIdentity:       ptr.To(eventID),
+		LeaseDurationSeconds: ptr.To(int32(concurrencyLeaseDuration.Seconds())),
+		AcquireTime:          &now,
+		RenewTime:            &now,
+	}
+
+	lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case kerrors.IsNotFound(err):
+		lease, err = leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: r.EventListenerNamespace,
+				Labels: map[string]string{
+					triggers.GroupName + triggers.EventListenerLabelKey:    r.EventListenerName,
+					triggers.GroupName + triggers.TriggerLabelKey:          t.Name,
+					triggers.GroupName + triggers.ConcurrencyGroupLabelKey: group,
+				},
+				OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(el)},
+			},
+			Spec: spec,
+		}, metav1.CreateOptions{})
+		if kerrors.IsAlreadyExists(err) {
+			return false, nil, nil
+		}
+	case err != nil:
+	case lease.Spec.RenewTime != nil && time.Since(lease.Spec.RenewTime.Time) < time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0))*time.Second:
+		// Another replica is applying the policy of the group
+		return false, nil, nil
+	default:
+		// The replica holding the Lease stopped before releasing it
+		lease.Spec = spec
+		lease, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+		if kerrors.IsConflict(err) {
+			return false, nil, nil
+		}
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("couldn't acquire the Lease of concurrency group %s: %w", group, err)
+	}
+
+	release := func() {
+		err := leases.Delete(ctx, name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &lease.ResourceVersion},
+		})
+		if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+			r.Logger.Warnf("failed to release the Lease of concurrency group %s: %v", group, err)
+		}
+	}
+	return true, release, nil
+}
+
+// concurrencyGroupLabel returns the value of the concurrency group label of
+// the resolved key: the key itself if it is a valid label value, or its hash.
+func concurrencyGroupLabel(key string) string {
+	if len(validation.IsValidLabelValue(key)) == 0 {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])[:40]
+}
+
+// withConcurrencyGroupLabel adds the concurrency group label to the resources.
+func withConcurrencyGroupLabel(res []json.RawMessage, group string) ([]json.RawMessage, error) {
+	path := "metadata.labels." + strings.ReplaceAll(triggers.GroupName+triggers.ConcurrencyGroupLabelKey, ".", `\.`)
+	out := make([]json.RawMessage, 0, len(res))
+	for _, rr := range res {
+		labelled, err := sjson.SetBytes(rr, path, group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add the concurrency group label: %w", err)
+		}
+		out = append(out, labelled)
+	}
+	return out, nil
+}
+
+// concurrencyOutcome is what happens to the resources of an event once the
+// policy of its concurrency group was applied.
+type concurrencyOutcome int
+
+const (
+	// concurrencyCreate creates the resources
+	concurrencyCreate concurrencyOutcome = iota
+	// concurrencySkip does not create the resources
+	concurrencySkip
+	// concurrencyWait waits for the running resources of the group to complete
+	concurrencyWait
+)
+
+// enforceConcurrency applies the policy of the Trigger to the PipelineRuns and
+// TaskRuns of the concurrency group that are still running, before the
+// resources of the event are created.
+func (r Sink) enforceConcurrency(c *triggersv1.Concurrency, t triggersv1.Trigger, group string, res []json.RawMessage, log *zap.SugaredLogger) (concurrencyOutcome, error) {
+	discoveryClient, dynamicClient, err := r.clientsFor(t.Spec.ServiceAccountName, t.Namespace, log)
+	if err != nil {
+		return concurrencyCreate, err
+	}
+	selector := labels.SelectorFromSet(labels.Set{
+		triggers.GroupName + triggers.EventListenerLabelKey:    r.EventListenerName,
+		triggers.GroupName + triggers.TriggerLabelKey:          t.Name,
+		triggers.GroupName + triggers.ConcurrencyGroupLabelKey: group,
+	}).String()
+
+	// The kinds and namespaces of the resources of the event are the ones
+	// where the resources of earlier events of the group were created
+	type location struct {
+		gvr       schema.GroupVersionResource
+		namespace string
+	}
+	var locations []location
+	seen := map[location]bool{}
+	for _, rr := range res {
+		data := new(unstructured.Unstructured)
+		if err := data.UnmarshalJSON(rr); err != nil {
+			return concurrencyCreate, fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
+		}
+		if _, ok := cancelledStatus[data.GroupVersionKind().GroupKind()]; !ok {
+			continue
+		}
+		gvr, err := resources.GroupVersionResource(data.GetAPIVersion(), data.GetKind(), discoveryClient)
+		if err != nil {
+			return concurrencyCreate, fmt.Errorf("couldn't find API resource for json: %w", err)
+		}
+		loc := location{gvr: gvr, namespace: data.GetNamespace()}
+		if loc.namespace == "" {
+			loc.namespace = t.Namespace
+		}
+		if !seen[loc] {
+			seen[loc] = true
+			locations = append(locations, loc)
+		}
+	}
+	if len(locations) == 0 {
+		return concurrencyCreate, nil
+	}
+
+	running := 0
+	for _, loc := range locations {
+		ri := dynamicClient.Resource(loc.gvr).Namespace(loc.namespace)
+		list, err := ri.List(context.Background(), metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return concurrencyCreate, fmt.Errorf("couldn't list the %s of concurrency group %s: %w", loc.gvr.Resource, group, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if !isRunning(obj) {
+				continue
+			}
+			running++
+			if c.GetPolicy() == triggersv1.ConcurrencyPolicyCancelInProgress {
+				if err := cancel(ri, obj, log); err != nil {
+					return concurrencyCreate, err
+				}
+			}
+		}
+	}
+	switch {
+	case running == 0 || c.GetPolicy() == triggersv1.ConcurrencyPolicyCancelInProgress:
+		return concurrencyCreate, nil
+	case c.GetPolicy() == triggersv1.ConcurrencyPolicySkipIfRunning:
+		log.Infof("skipping the resources of the event, %d resources of concurrency group %s are running", running, group)
+		return concurrencySkip, nil
+	}
+	log.Infof("waiting for %d resources of concurrency group %s to complete", running, group)
+	return concurrencyWait, nil
+}
+
+// isRunning returns true if the resource has not completed yet, that is if
+// its Succeeded condition is missing or unknown.
+func isRunning(obj *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if ok && cond["type"] == "Succeeded" {
+			return cond["status"] == "Unknown"
+		}
+	}
+	return true
+}
+
+func cancel(ri dynamic.ResourceInterface, obj *unstructured.Unstructured, log *zap.SugaredLogger) error {
+	log.Infof("cancelling %s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"status": cancelledStatus[obj.GroupVersionKind().GroupKind()]},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := ri.Patch(context.Background(), obj.GetName(), types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("couldn't cancel %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+	return nil
+}
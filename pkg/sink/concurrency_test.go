@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestHandleEvent_Concurrency(t *testing.T) {
+	concurrencyPollInterval = 10 * time.Millisecond
+	defer func() { concurrencyPollInterval = 10 * time.Second }()
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	taskRun := func(name, group, succeeded string) *unstructured.Unstructured {
+		tr := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "tekton.dev/v1",
+			"kind":       "TaskRun",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+				"labels": map[string]interface{}{
+					triggers.GroupName + triggers.EventListenerLabelKey:    "my-el",
+					triggers.GroupName + triggers.TriggerLabelKey:          "pr-trigger",
+					triggers.GroupName + triggers.ConcurrencyGroupLabelKey: group,
+				},
+			},
+			"spec": map[string]interface{}{},
+		}}
+		if succeeded != "" {
+			tr.Object["status"] = map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{"type": "Succeeded", "status": succeeded}},
+			}
+		}
+		return tr
+	}
+
+	for _, tc := range []struct {
+		name   string
+		policy triggersv1beta1.ConcurrencyPolicy
+		// running is the group of the earlier TaskRun that is still running
+		running       string
+		wantSkipped   bool
+		wantCancelled bool
+	}{{
+		name:          "cancel in progress",
+		policy:        triggersv1beta1.ConcurrencyPolicyCancelInProgress,
+		running:       "42",
+		wantCancelled: true,
+	}, {
+		name:        "skip if running",
+		policy:      triggersv1beta1.ConcurrencyPolicySkipIfRunning,
+		running:     "42",
+		wantSkipped: true,
+	}, {
+		name:    "skip if running in another group",
+		policy:  triggersv1beta1.ConcurrencyPolicySkipIfRunning,
+		running: "43",
+	}, {
+		name:    "queue",
+		running: "42",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-el",
+					Namespace: namespace,
+					UID:       types.UID(elUID),
+				},
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						Name: "pr-trigger",
+						Bindings: []*triggersv1beta1.EventListenerBinding{
+							{Name: "url", Value: ptr.String("$(body.repository.url)")},
+							{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+							{Name: "name", Value: ptr.String("$(body.name)")},
+						},
+						Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+						Concurrency: &triggersv1beta1.Concurrency{
+							Key:    "$(body.pull_request.number)",
+							Policy: tc.policy,
+						},
+					}},
+				},
+			}
+			sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+			sink.ConcurrencyGroups = NewConcurrencyGroups()
+			sink.SyncResponseTimeout = 5 * time.Second
+			dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{gvr: "TaskRunList"},
+				taskRun("earlier-run", tc.running, ""),
+				taskRun("completed-run", "42", "True"))
+			sink.DynamicClient = dynamicClient
+
+			// Queued events poll the group until the earlier TaskRun completes
+			var lists atomic.Int32
+			dynamicClient.PrependReactor("list", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+				if lists.Add(1) == 2 {
+					if err := dynamicClient.Tracker().Update(gvr, taskRun("earlier-run", tc.running, "True"), namespace); err != nil {
+						t.Errorf("error completing the earlier TaskRun: %v", err)
+					}
+				}
+				return false, nil, nil
+			})
+
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+			body := `{"pull_request": {"number": 42}, "name": "new-run", "head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`
+			req, _ := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader([]byte(body)))
+			req.Header.Set(ResponseModeHeader, SyncResponseMode)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			defer resp.Body.Close()
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("error decoding response: %v", err)
+			}
+			sink.WGProcessTriggers.Wait()
+
+			if len(got.Triggers) != 1 || got.Triggers[0].Skipped != tc.wantSkipped || got.Triggers[0].Error != "" {
+				t.Fatalf("got triggers %+v, want skipped to be %t", got.Triggers, tc.wantSkipped)
+			}
+
+			newRun, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "new-run", metav1.GetOptions{})
+			switch {
+			case tc.wantSkipped && err == nil:
+				t.Error("the TaskRun of a skipped event should not have been created")
+			case !tc.wantSkipped && err != nil:
+				t.Fatalf("error getting the new TaskRun: %v", err)
+			case !tc.wantSkipped:
+				if got := newRun.GetLabels()[triggers.GroupName+triggers.ConcurrencyGroupLabelKey]; got != "42" {
+					t.Errorf("got concurrency group label %q, want 42", got)
+				}
+			}
+
+			for name, wantCancelled := range map[string]bool{"earlier-run": tc.wantCancelled, "completed-run": false} {
+				tr, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("error getting TaskRun %s: %v", name, err)
+				}
+				status, _, _ := unstructured.NestedString(tr.Object, "spec", "status")
+				if cancelled := status == "TaskRunCancelled"; cancelled != wantCancelled {
+					t.Errorf("got spec.status %q for TaskRun %s, want cancelled to be %t", status, name, wantCancelled)
+				}
+			}
+			if tc.policy == "" && tc.running == "42" && lists.Load() < 2 {
+				t.Errorf("got %d lists of the group, want the event to be queued", lists.Load())
+			}
+		})
+	}
+}
+
+func TestConcurrencyGroups(t *testing.T) {
+	concurrencyPollInterval = 10 * time.Millisecond
+	defer func() { concurrencyPollInterval = 10 * time.Second }()
+	resume := func(f func()) { go f() }
+
+	g := NewConcurrencyGroups()
+	var running atomic.Bool
+	running.Store(true)
+	processed := make(chan string, 10)
+	dropped := make(chan error, 10)
+	event := func(id string, timeout time.Duration) *queuedEvent {
+		return &queuedEvent{
+			eventID: id,
+			timeout: timeout,
+			process: func() bool {
+				if running.Load() {
+					return true
+				}
+				processed <- id
+				return false
+			},
+			drop: func(err error) { dropped <- fmt.Errorf("%s: %w", id, err) },
+		}
+	}
+
+	// The events wait for the running resources in order of arrival
+	for _, id := range []string{"first", "second", "third", "fourth"} {
+		g.run("group", event(id, time.Hour), 3, resume)
+	}
+	if err := <-dropped; !errors.Is(err, errConcurrencyQueueFull) {
+		t.Errorf("got error %v, want the fourth event to find the queue full", err)
+	}
+	g.run("other", event("other", time.Millisecond), 3, resume)
+	if err := <-dropped; !errors.Is(err, errConcurrencyQueueTimeout) {
+		t.Errorf("got error %v, want the event of the other group to time out", err)
+	}
+	running.Store(false)
+	var got []string
+	for range 3 {
+		select {
+		case id := <-processed:
+			got = append(got, id)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the queued events, got %v", got)
+		}
+	}
+	if diff := cmp.Diff([]string{"first", "second", "third"}, got); diff != "" {
+		t.Errorf("processed events (-want, +got): %s", diff)
+	}
+
+	// The queued events are abandoned on shutdown
+	running.Store(true)
+	g.run("group", event("waiting", time.Hour), 3, resume)
+	g.run("group", event("queued", time.Hour), 3, resume)
+	if diff := cmp.Diff([]string{"waiting", "queued"}, g.abandon(), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("abandoned events (-want, +got): %s", diff)
+	}
+	g.run("group", event("late", time.Hour), 3, resume)
+	for range 3 {
+		if err := <-dropped; !errors.Is(err, errAbandoned) {
+			t.Errorf("got error %v, want the event to be abandoned", err)
+		}
+	}
+
+	var nilGroups *ConcurrencyGroups
+	nilGroups.run("group", event("nil", time.Hour), 3, resume)
+	if err := <-dropped; !errors.Is(err, errConcurrencyQueueFull) {
+		t.Errorf("got error %v, want a nil ConcurrencyGroups to fail the events that must wait", err)
+	}
+}
+
+func TestConcurrencyGroupLabel(t *testing.T) {
+	if got := concurrencyGroupLabel("main"); got != "main" {
+		t.Errorf("got label %q, want the key itself", got)
+	}
+	key := "https://github.com/tektoncd/triggers/pull/42"
+	got := concurrencyGroupLabel(key)
+	if len(got) != 40 || strings.Contains(got, "/") || got != concurrencyGroupLabel(key) {
+		t.Errorf("got label %q, want a stable hash of the key", got)
+	}
+}
+
+func TestHoldConcurrencyGroup(t *testing.T) {
+	ctx := context.Background()
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{}, el.Name, nil)
+	trigger := triggersv1beta1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "my-trigger", Namespace: namespace}}
+	leases := sink.KubeClientSet.CoordinationV1().Leases(namespace)
+
+	hold := func(group, eventID string) (bool, func()) {
+		t.Helper()
+		held, release, err := sink.holdConcurrencyGroup(ctx, el, trigger, group, eventID)
+		if err != nil {
+			t.Fatalf("holdConcurrencyGroup() returned unexpected error: %v", err)
+		}
+		return held, release
+	}
+
+	held, release := hold("pr-1", "event-1")
+	if !held {
+		t.Fatal("the first event of the group did not hold it")
+	}
+	if held, _ := hold("pr-1", "event-2"); held {
+		t.Error("an event held the group held by another event")
+	}
+	if held, _ := hold("pr-2", "event-2"); !held {
+		t.Error("an event did not hold another group")
+	}
+	release()
+	held, _ = hold("pr-1", "event-2")
+	if !held {
+		t.Fatal("an event did not hold the group once it was released")
+	}
+
+	// A Lease that was not renewed is taken over
+	list, err := leases.List(ctx, metav1.ListOptions{LabelSelector: triggers.GroupName + triggers.ConcurrencyGroupLabelKey + "=pr-1"})
+	if err != nil || len(list.Items) != 1 {
+		t.Fatalf("got Leases %v and error %v, want the Lease of the group", list, err)
+	}
+	lease := &list.Items[0]
+	if len(lease.OwnerReferences) != 1 || lease.OwnerReferences[0].UID != el.UID {
+		t.Errorf("got owner references %+v, want the EventListener", lease.OwnerReferences)
+	}
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: time.Now().Add(-2 * concurrencyLeaseDuration)}
+	if _, err := leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error updating Lease: %v", err)
+	}
+	if held, _ := hold("pr-1", "event-3"); !held {
+		t.Error("an event did not take over a Lease that was not renewed")
+	}
+	lease, err = leases.Get(ctx, lease.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting Lease: %v", err)
+	}
+	if got := ptr.StringValue(lease.Spec.HolderIdentity); got != "event-3" {
+		t.Errorf("got holder %q, want event-3", got)
+	}
+}
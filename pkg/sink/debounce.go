@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"sync"
+	"time"
+)
+
+// Debouncer holds the events of Triggers with a debounce so that only the
+// latest event of a burst with the same key is processed. The held events are
+// kept outside of the workers, and only take a worker once their window
+// expires. Each replica of the EventListener has its own Debouncer, so the
+// events of a burst received by different replicas are debounced separately.
+// A nil Debouncer does not hold any event.
+type Debouncer struct {
+	mu      sync.Mutex
+	pending map[string]*pendingEvent
+	// abandoned is true once the EventListener shuts down
+	abandoned bool
+}
+
+// pendingEvent is the latest event held for a debounce key.
+type pendingEvent struct {
+	eventID string
+	// process processes the event once no newer event with the same key was
+	// received for the window
+	process func()
+	// supersede is called when a newer event with the same key is received
+	supersede func()
+	// drop is called with the reason the event was not processed
+	drop func(error)
+
+	timer *time.Timer
+}
+
+// NewDebouncer returns a Debouncer holding no event.
+func NewDebouncer() *Debouncer {
+	return &Debouncer{pending: map[string]*pendingEvent{}}
+}
+
+// hold holds the event until no newer event with the same key is received
+// for the window, then processes it with the function passed to resume. The
+// event is superseded as soon as a newer event with the same key is received.
+func (d *Debouncer) hold(key string, window time.Duration, e *pendingEvent, resume func(func())) {
+	if d == nil {
+		e.process()
+		return
+	}
+	d.mu.Lock()
+	if d.abandoned {
+		d.mu.Unlock()
+		e.drop(errAbandoned)
+		return
+	}
+	// The previous event is still pending until its window expires and it is
+	// removed, so it can be superseded even if its timer already fired
+	previous, ok := d.pending[key]
+	if ok {
+		previous.timer.Stop()
+	}
+	d.pending[key] = e
+	e.timer = time.AfterFunc(window, func() {
+		d.mu.Lock()
+		// A newer event may have been received, or the EventListener may have
+		// shut down, right as the window ended
+		if d.pending[key] != e {
+			d.mu.Unlock()
+			return
+		}
+		delete(d.pending, key)
+		d.mu.Unlock()
+		resume(e.process)
+	})
+	d.mu.Unlock()
+	if ok {
+		previous.supersede()
+	}
+}
+
+// abandon drops the held events, and the events received afterwards, as the
+// EventListener shuts down. It returns the IDs of the dropped events.
+func (d *Debouncer) abandon() []string {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	d.abandoned = true
+	held := make([]*pendingEvent, 0, len(d.pending))
+	for key, e := range d.pending {
+		e.timer.Stop()
+		held = append(held, e)
+		delete(d.pending, key)
+	}
+	d.mu.Unlock()
+
+	ids := make([]string, 0, len(held))
+	for _, e := range held {
+		e.drop(errAbandoned)
+		ids = append(ids, e.eventID)
+	}
+	return ids
+}
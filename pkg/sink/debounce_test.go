@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+func TestDebouncer(t *testing.T) {
+	d := NewDebouncer()
+	results := make(chan string, 4)
+	superseded := make(chan string, 4)
+	// The held events do not block the caller
+	resumed := make(chan struct{}, 4)
+	hold := func(key, event string) {
+		d.hold(key, 200*time.Millisecond, &pendingEvent{
+			eventID:   event,
+			process:   func() { results <- event },
+			supersede: func() { superseded <- event },
+			drop:      func(err error) { t.Errorf("event %s dropped: %v", event, err) },
+		}, func(f func()) {
+			resumed <- struct{}{}
+			f()
+		})
+	}
+	hold("main", "first")
+	time.Sleep(50 * time.Millisecond)
+	hold("main", "second")
+	hold("other", "other")
+	time.Sleep(50 * time.Millisecond)
+	hold("main", "third")
+
+	var got []string
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-results:
+			got = append(got, e)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the debounced events, got %v", got)
+		}
+	}
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if diff := cmp.Diff([]string{"other", "third"}, got, sortStrings); diff != "" {
+		t.Errorf("processed events (-want, +got): %s", diff)
+	}
+	close(superseded)
+	var gotSuperseded []string
+	for e := range superseded {
+		gotSuperseded = append(gotSuperseded, e)
+	}
+	if diff := cmp.Diff([]string{"first", "second"}, gotSuperseded, sortStrings); diff != "" {
+		t.Errorf("superseded events (-want, +got): %s", diff)
+	}
+	if len(resumed) != 2 {
+		t.Errorf("got %d events resumed, want 2", len(resumed))
+	}
+
+	var processed bool
+	var nilDebouncer *Debouncer
+	nilDebouncer.hold("main", time.Hour, &pendingEvent{process: func() { processed = true }}, nil)
+	if !processed {
+		t.Error("a nil Debouncer should not hold events")
+	}
+
+	// Events held after the EventListener shuts down are dropped
+	d.abandon()
+	var dropped error
+	d.hold("main", time.Hour, &pendingEvent{drop: func(err error) { dropped = err }}, nil)
+	if dropped != errAbandoned {
+		t.Errorf("got %v dropping the event, want %v", dropped, errAbandoned)
+	}
+}
+
+func TestHandleEvent_Debounce(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "push-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+					{Name: "name", Value: ptr.String("$(body.head_commit.id)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+				Debounce: &triggersv1beta1.Debounce{
+					Key:    "$(body.ref)",
+					Window: metav1.Duration{Duration: time.Second},
+				},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.Debouncer = NewDebouncer()
+	// The held events do not use the only worker
+	sink.Workers = NewWorkerPool(1, 0)
+
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+	for _, e := range []struct{ ref, commit string }{
+		{ref: "main", commit: "commit-1"},
+		{ref: "main", commit: "commit-2"},
+		{ref: "release", commit: "commit-3"},
+		{ref: "main", commit: "commit-4"},
+	} {
+		body := fmt.Sprintf(`{"ref": %q, "head_commit": {"id": %q}, "repository": {"url": "testurl"}}`, e.ref, e.commit)
+		resp, err := http.Post(ts.URL, "application/json", bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatalf("error sending request: %s", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusAccepted {
+			t.Errorf("got status %d for the event of %s, want %d", resp.StatusCode, e.commit, http.StatusAccepted)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	sink.WGProcessTriggers.Wait()
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	var got []string
+	for _, name := range []string{"commit-1", "commit-2", "commit-3", "commit-4"} {
+		if _, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{}); err == nil {
+			got = append(got, name)
+		}
+	}
+	if diff := cmp.Diff([]string{"commit-3", "commit-4"}, got); diff != "" {
+		t.Errorf("created TaskRuns (-want, +got): %s", diff)
+	}
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/interceptors/webhook"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// How a Trigger is selected by the EventListener, reported by the debug endpoints.
+const (
+	// selectedByTriggers is for the Triggers listed in the triggers of the EventListener
+	selectedByTriggers = "triggers"
+	// selectedBySelector is for the Triggers matching the namespace and label
+	// selectors of the EventListener
+	selectedBySelector = "selector"
+	// selectedByTriggerGroup is for the Triggers matching the trigger selector
+	// of a TriggerGroup
+	selectedByTriggerGroup = "triggerGroup"
+)
+
+// Decision is the outcome of a Trigger for an event, kept by the DecisionStore.
+type Decision struct {
+	EventID string    `json:"eventID"`
+	Time    time.Time `json:"time"`
+	TriggerResult
+	// Interceptors are the outcomes of the interceptors of the Trigger called
+	// for the event, in order.
+	Interceptors []triggersv1alpha1.InterceptorResult `json:"interceptors,omitempty"`
+}
+
+// DecisionStore keeps the most recent decisions of each Trigger so that they
+// can be listed through the debug endpoints. A nil DecisionStore does not keep
+// anything.
+type DecisionStore struct {
+	maxDecisions int
+
+	mu sync.Mutex
+	// decisions maps the namespace/name of each Trigger to its decisions,
+	// sorted from the oldest to the most recent
+	decisions map[string][]Decision
+}
+
+// NewDecisionStore returns a DecisionStore that keeps the maxDecisions most
+// recent decisions of each Trigger. It returns nil if maxDecisions is not positive.
+func NewDecisionStore(maxDecisions int) *DecisionStore {
+	if maxDecisions <= 0 {
+		return nil
+	}
+	return &DecisionStore{
+		maxDecisions: maxDecisions,
+		decisions:    map[string][]Decision{},
+	}
+}
+
+// record keeps the result of a Trigger for the event, and the outcomes of its
+// interceptors.
+func (s *DecisionStore) record(eventID string, result TriggerResult, interceptors []triggersv1alpha1.InterceptorResult) {
+	if s == nil || result.Trigger == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := result.Namespace + "/" + result.Trigger
+	decisions := append(s.decisions[key], Decision{
+		EventID:       eventID,
+		Time:          time.Now(),
+		TriggerResult: result,
+		Interceptors:  interceptors,
+	})
+	if len(decisions) > s.maxDecisions {
+		decisions = append([]Decision(nil), decisions[len(decisions)-s.maxDecisions:]...)
+	}
+	s.decisions[key] = decisions
+}
+
+// list returns the decisions kept for the Trigger, from the most recent to the oldest.
+func (s *DecisionStore) list(namespace, name string) []Decision {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	decisions := s.decisions[namespace+"/"+name]
+	out := make([]Decision, 0, len(decisions))
+	for i := len(decisions) - 1; i >= 0; i-- {
+		out = append(out, decisions[i])
+	}
+	return out
+}
+
+// DebugInterceptor describes an interceptor a Trigger or TriggerGroup calls.
+type DebugInterceptor struct {
+	// Name is the name of the interceptor, or "webhook" for webhook interceptors.
+	Name string `json:"name"`
+	// Kind is the kind of the interceptor, or "Webhook" for webhook interceptors.
+	Kind string `json:"kind,omitempty"`
+	// URL is the resolved URL the interceptor is called at.
+	URL string `json:"url,omitempty"`
+	// InProcess is true if the interceptor is a core interceptor run in the
+	// EventListener process rather than called at its URL.
+	InProcess bool `json:"inProcess,omitempty"`
+	// Path is the path of the core interceptor run in-process.
+	Path string `json:"path,omitempty"`
+	// Error is the error that occurred resolving the URL of the interceptor.
+	Error string `json:"error,omitempty"`
+}
+
+// DebugTrigger describes a Trigger resolved by the EventListener.
+type DebugTrigger struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// SelectedBy is how the EventListener selects the Trigger: "triggers" if
+	// it is listed in the triggers of the EventListener, "selector" if it
+	// matches the namespace and label selectors of the EventListener, or
+	// "triggerGroup" if it matches the trigger selector of a TriggerGroup.
+	SelectedBy string `json:"selectedBy"`
+	// TriggerGroup is the name of the TriggerGroup that selects the Trigger, if any.
+	TriggerGroup string                   `json:"triggerGroup,omitempty"`
+	Match        *triggersv1.RequestMatch `json:"match,omitempty"`
+	Schedule     *triggersv1.Schedule     `json:"schedule,omitempty"`
+	Interceptors []DebugInterceptor       `json:"interceptors,omitempty"`
+	// Decisions are the most recent decisions of the Trigger, from the most
+	// recent to the oldest.
+	Decisions []Decision `json:"decisions,omitempty"`
+	// Error is the error that occurred resolving the Trigger.
+	Error string `json:"error,omitempty"`
+}
+
+// DebugTriggerGroup describes a TriggerGroup of the EventListener.
+type DebugTriggerGroup struct {
+	Name            string                                  `json:"name"`
+	Match           *triggersv1.RequestMatch                `json:"match,omitempty"`
+	TriggerSelector triggersv1.EventListenerTriggerSelector `json:"triggerSelector"`
+	Interceptors    []DebugInterceptor                      `json:"interceptors,omitempty"`
+	// Triggers are the Triggers selected by the TriggerGroup.
+	Triggers []DebugTrigger `json:"triggers,omitempty"`
+	// Error is the error that occurred selecting the Triggers.
+	Error string `json:"error,omitempty"`
+}
+
+// DebugTriggers describes the Triggers and TriggerGroups resolved by the
+// EventListener, as they are resolved to process an event.
+type DebugTriggers struct {
+	// Pod is the replica of the EventListener whose decisions are listed.
+	Pod               string                       `json:"pod,omitempty"`
+	EventListener     string                       `json:"eventListener"`
+	Namespace         string                       `json:"namespace"`
+	NamespaceSelector triggersv1.NamespaceSelector `json:"namespaceSelector,omitempty"`
+	LabelSelector     *metav1.LabelSelector        `json:"labelSelector,omitempty"`
+	Triggers          []DebugTrigger               `json:"triggers"`
+	TriggerGroups     []DebugTriggerGroup          `json:"triggerGroups,omitempty"`
+}
+
+// DebugHandler returns the handler of the debug endpoints of the EventListener,
+// which describe the Triggers it resolves, the interceptors they call and their
+// most recent decisions. Requests must have the DebugToken as bearer token.
+func (r Sink) DebugHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /debug/triggers", r.debugTriggers)
+	mux.HandleFunc("GET /debug/triggers/{namespace}/{name}", r.debugTrigger)
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if r.Decisions == nil {
+			http.NotFound(response, request)
+			return
+		}
+		if !authorized(response, request, r.DebugToken) {
+			return
+		}
+		mux.ServeHTTP(response, request)
+	})
+}
+
+func (r Sink) debugTriggers(response http.ResponseWriter, _ *http.Request) {
+	d, err := r.resolveDebugTriggers()
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	r.writeJSON(response, d)
+}
+
+// debugTrigger describes a single Trigger, once for each way it is selected.
+func (r Sink) debugTrigger(response http.ResponseWriter, request *http.Request) {
+	d, err := r.resolveDebugTriggers()
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	namespace, name := request.PathValue("namespace"), request.PathValue("name")
+	var found []DebugTrigger
+	for _, t := range d.Triggers {
+		if t.Namespace == namespace && t.Name == name {
+			found = append(found, t)
+		}
+	}
+	for _, g := range d.TriggerGroups {
+		for _, t := range g.Triggers {
+			if t.Namespace == namespace && t.Name == name {
+				found = append(found, t)
+			}
+		}
+	}
+	if len(found) == 0 {
+		http.Error(response, fmt.Sprintf("trigger %s/%s is not selected by the EventListener", namespace, name), http.StatusNotFound)
+		return
+	}
+	r.writeJSON(response, struct {
+		Pod      string         `json:"pod,omitempty"`
+		Triggers []DebugTrigger `json:"triggers"`
+	}{Pod: d.Pod, Triggers: found})
+}
+
+// resolveDebugTriggers resolves the Triggers and TriggerGroups of the
+// EventListener like HandleEvent does.
+func (r Sink) resolveDebugTriggers() (DebugTriggers, error) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		return DebugTriggers{}, fmt.Errorf("error getting EventListener %s in namespace %s: %w", r.EventListenerName, r.EventListenerNamespace, err)
+	}
+	d := DebugTriggers{
+		Pod:               r.PodName,
+		EventListener:     el.Name,
+		Namespace:         el.Namespace,
+		NamespaceSelector: el.Spec.NamespaceSelector,
+		LabelSelector:     el.Spec.LabelSelector,
+		Triggers:          []DebugTrigger{},
+	}
+
+	selected, err := r.selectTriggers(el.Spec.NamespaceSelector, el.Spec.LabelSelector)
+	if err != nil {
+		return DebugTriggers{}, fmt.Errorf("unable to select triggers: %w", err)
+	}
+	for _, t := range selected {
+		d.Triggers = append(d.Triggers, r.debugTriggerOf(t, selectedBySelector, ""))
+	}
+	listed, err := r.merge(el.Spec.Triggers, nil)
+	if err != nil {
+		return DebugTriggers{}, fmt.Errorf("error merging triggers: %w", err)
+	}
+	for _, t := range listed {
+		d.Triggers = append(d.Triggers, r.debugTriggerOf(t, selectedByTriggers, ""))
+	}
+	// merge skips the Triggers referenced by the EventListener that cannot be found
+	for _, t := range el.Spec.Triggers {
+		if t.Template != nil || t.TriggerRef == "" {
+			continue
+		}
+		if _, err := r.TriggerLister.Triggers(r.EventListenerNamespace).Get(t.TriggerRef); err != nil {
+			d.Triggers = append(d.Triggers, DebugTrigger{
+				Name:       t.TriggerRef,
+				Namespace:  r.EventListenerNamespace,
+				SelectedBy: selectedByTriggers,
+				Error:      err.Error(),
+			})
+		}
+	}
+
+	for _, g := range el.Spec.TriggerGroups {
+		dg := DebugTriggerGroup{
+			Name:            g.Name,
+			Match:           g.Match,
+			TriggerSelector: g.TriggerSelector,
+			Interceptors:    r.debugInterceptors(g.Interceptors, r.EventListenerNamespace),
+		}
+		trs, err := r.selectTriggers(g.TriggerSelector.NamespaceSelector, g.TriggerSelector.LabelSelector)
+		if err != nil {
+			dg.Error = err.Error()
+		}
+		for _, t := range trs {
+			dg.Triggers = append(dg.Triggers, r.debugTriggerOf(t, selectedByTriggerGroup, g.Name))
+		}
+		d.TriggerGroups = append(d.TriggerGroups, dg)
+	}
+	return d, nil
+}
+
+func (r Sink) debugTriggerOf(t *triggersv1.Trigger, selectedBy, group string) DebugTrigger {
+	return DebugTrigger{
+		Name:         t.Name,
+		Namespace:    t.Namespace,
+		SelectedBy:   selectedBy,
+		TriggerGroup: group,
+		Match:        t.Spec.Match,
+		Schedule:     t.Spec.Schedule,
+		Interceptors: r.debugInterceptors(t.Spec.Interceptors, t.Namespace),
+		Decisions:    r.Decisions.list(t.Namespace, t.Name),
+	}
+}
+
+// debugInterceptors resolves the interceptors like executeInterceptors does,
+// without calling them. Webhook interceptors are resolved in namespace ns.
+func (r Sink) debugInterceptors(trInt []*triggersv1.TriggerInterceptor, ns string) []DebugInterceptor {
+	out := make([]DebugInterceptor, 0, len(trInt))
+	for _, i := range trInt {
+		if i.Webhook != nil {
+			d := DebugInterceptor{Name: "webhook", Kind: "Webhook"}
+			if u, err := webhook.ResolveURL(i.Webhook, ns); err != nil {
+				d.Error = err.Error()
+			} else {
+				d.URL = u.String()
+			}
+			out = append(out, d)
+			continue
+		}
+		d := DebugInterceptor{Name: i.GetName(), Kind: string(i.Ref.Kind)}
+		if path, ok := r.coreInterceptorPath(i); ok {
+			d.InProcess = true
+			d.Path = path
+		} else if u, err := r.interceptorURL(i); err != nil {
+			d.Error = err.Error()
+		} else if u != nil {
+			d.URL = u.String()
+		}
+		out = append(out, d)
+	}
+	return out
+}
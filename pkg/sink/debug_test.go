@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/interceptors"
+	"github.com/tektoncd/triggers/pkg/interceptors/server"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestDecisionStore(t *testing.T) {
+	s := NewDecisionStore(2)
+	for _, id := range []string{"1", "2", "3"} {
+		s.record(id, TriggerResult{Trigger: "a", Namespace: "ns"}, nil)
+	}
+	s.record("4", TriggerResult{Trigger: "b", Namespace: "ns"}, nil)
+	// The results of TriggerGroups stopped before selecting Triggers are not kept
+	s.record("5", TriggerResult{TriggerGroup: "group", Namespace: "ns"}, nil)
+
+	var ids []string
+	for _, d := range s.list("ns", "a") {
+		ids = append(ids, d.EventID)
+	}
+	if diff := cmp.Diff([]string{"3", "2"}, ids); diff != "" {
+		t.Errorf("list() (-want,+got): %s", diff)
+	}
+	if got := s.list("ns", "b"); len(got) != 1 || got[0].EventID != "4" {
+		t.Errorf("list() = %+v, want the decision of event 4", got)
+	}
+
+	if NewDecisionStore(0) != nil {
+		t.Error("NewDecisionStore(0) should be nil")
+	}
+	var nilStore *DecisionStore
+	nilStore.record("1", TriggerResult{Trigger: "a", Namespace: "ns"}, nil)
+	if got := nilStore.list("ns", "a"); got != nil {
+		t.Errorf("list() = %v, want nil", got)
+	}
+}
+
+func TestDebugHandler(t *testing.T) {
+	celInterceptor := func(filter string) *triggersv1beta1.TriggerInterceptor {
+		return &triggersv1beta1.TriggerInterceptor{
+			Ref:    triggersv1beta1.InterceptorRef{Name: "cel", Kind: triggersv1beta1.ClusterInterceptorKind},
+			Params: []triggersv1beta1.InterceptorParams{{Name: "filter", Value: test.ToV1JSON(t, filter)}},
+		}
+	}
+	selected := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "selected",
+			Namespace: namespace,
+			Labels:    map[string]string{"foo": "bar"},
+		},
+		Spec: triggersv1beta1.TriggerSpec{
+			Interceptors: []*triggersv1beta1.TriggerInterceptor{
+				celInterceptor("has(body.head_commit)"),
+				{Webhook: &triggersv1beta1.WebhookInterceptor{URL: apis.HTTP("webhook.example.com")}},
+				{Ref: triggersv1beta1.InterceptorRef{Name: "missing", Kind: triggersv1beta1.ClusterInterceptorKind}},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name:         "listed",
+				Interceptors: []*triggersv1beta1.TriggerInterceptor{celInterceptor("body.action == 'opened'")},
+				Template:     &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "listed-run")},
+			}, {
+				TriggerRef: "unknown",
+			}},
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{{
+				Name:         "group",
+				Interceptors: []*triggersv1beta1.TriggerInterceptor{celInterceptor("true")},
+				TriggerSelector: triggersv1beta1.EventListenerTriggerSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				},
+			}},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{
+		EventListeners:      []*triggersv1beta1.EventListener{el},
+		Triggers:            []*triggersv1beta1.Trigger{selected},
+		ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{cel},
+	}, el.Name, nil)
+	sink.Decisions = NewDecisionStore(10)
+	sink.DebugToken = "debug-token"
+	sink.PodName = "el-my-el-1234"
+
+	// The listed trigger filters the event out
+	eventServer := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer eventServer.Close()
+	resp, err := http.Post(eventServer.URL, "application/json", bytes.NewReader([]byte(`{"action": "closed"}`)))
+	if err != nil {
+		t.Fatalf("error sending event: %v", err)
+	}
+	resp.Body.Close()
+	sink.WGProcessTriggers.Wait()
+
+	debugServer := httptest.NewServer(sink.DebugHandler())
+	defer debugServer.Close()
+	get := func(path, token string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, debugServer.URL+path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("error sending debug request: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	for _, token := range []string{"", "wrong-token"} {
+		if resp := get("/debug/triggers", token); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("got status %d with token %q, want 401", resp.StatusCode, token)
+		}
+	}
+
+	resp = get("/debug/triggers", "debug-token")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d, want 200", resp.StatusCode)
+	}
+	var got DebugTriggers
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("error decoding debug response: %v", err)
+	}
+
+	celURL := "http://tekton-triggers-core-interceptors/cel"
+	selectedInterceptors := []DebugInterceptor{
+		{Name: "cel", Kind: "ClusterInterceptor", URL: celURL},
+		{Name: "webhook", Kind: "Webhook", URL: "http://webhook.example.com"},
+		{Name: "missing", Kind: "ClusterInterceptor", Error: `url resolution failed for interceptor missing with: clusterinterceptor.triggers.tekton.dev "missing" not found`},
+	}
+	want := DebugTriggers{
+		Pod:           "el-my-el-1234",
+		EventListener: el.Name,
+		Namespace:     namespace,
+		LabelSelector: el.Spec.LabelSelector,
+		Triggers: []DebugTrigger{{
+			Name:         "selected",
+			Namespace:    namespace,
+			SelectedBy:   "selector",
+			Interceptors: selectedInterceptors,
+		}, {
+			Name:         "listed",
+			Namespace:    namespace,
+			SelectedBy:   "triggers",
+			Interceptors: []DebugInterceptor{{Name: "cel", Kind: "ClusterInterceptor", URL: celURL}},
+			Decisions: []Decision{{
+				EventID: eventID,
+				TriggerResult: TriggerResult{
+					Trigger:   "listed",
+					Namespace: namespace,
+					Stopped:   true,
+					Status:    &triggersv1beta1.Status{Code: 9, Message: "expression body.action == 'opened' did not return true"},
+				},
+				Interceptors: []triggersv1alpha1.InterceptorResult{{Name: "cel", Message: "expression body.action == 'opened' did not return true"}},
+			}},
+		}, {
+			Name:       "unknown",
+			Namespace:  namespace,
+			SelectedBy: "triggers",
+			Error:      `trigger.triggers.tekton.dev "unknown" not found`,
+		}},
+		TriggerGroups: []DebugTriggerGroup{{
+			Name:            "group",
+			TriggerSelector: el.Spec.TriggerGroups[0].TriggerSelector,
+			Interceptors:    []DebugInterceptor{{Name: "cel", Kind: "ClusterInterceptor", URL: celURL}},
+			Triggers: []DebugTrigger{{
+				Name:         "selected",
+				Namespace:    namespace,
+				SelectedBy:   "triggerGroup",
+				TriggerGroup: "group",
+				Interceptors: selectedInterceptors,
+			}},
+		}},
+	}
+	// The selected trigger also processed the event, its decisions are checked below
+	for i := range got.Triggers {
+		if got.Triggers[i].Name == "selected" {
+			got.Triggers[i].Decisions = nil
+		}
+	}
+	for i := range got.TriggerGroups {
+		for j := range got.TriggerGroups[i].Triggers {
+			got.TriggerGroups[i].Triggers[j].Decisions = nil
+		}
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(Decision{}, "Time")); diff != "" {
+		t.Errorf("/debug/triggers (-want,+got): %s", diff)
+	}
+
+	resp = get("/debug/triggers/"+namespace+"/selected", "debug-token")
+	var single struct {
+		Pod      string         `json:"pod"`
+		Triggers []DebugTrigger `json:"triggers"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&single); err != nil {
+		t.Fatalf("error decoding debug response: %v", err)
+	}
+	if single.Pod != "el-my-el-1234" {
+		t.Errorf("got pod %q, want el-my-el-1234", single.Pod)
+	}
+	if len(single.Triggers) != 2 || single.Triggers[0].SelectedBy != "selector" || single.Triggers[1].SelectedBy != "triggerGroup" {
+		t.Fatalf("got %+v, want the trigger selected by the selector and by the group", single.Triggers)
+	}
+	for _, tr := range single.Triggers {
+		if len(tr.Decisions) != 2 {
+			t.Errorf("got decisions %+v, want the decisions of the trigger selected by the selector and by the group", tr.Decisions)
+		}
+	}
+
+	if resp := get("/debug/triggers/"+namespace+"/other", "debug-token"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status %d for an unknown trigger, want 404", resp.StatusCode)
+	}
+}
+
+func TestDebugInterceptors_InProcess(t *testing.T) {
+	celService := &triggersv1alpha1.ClusterInterceptor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cel-service"},
+		Spec: triggersv1alpha1.ClusterInterceptorSpec{
+			ClientConfig: triggersv1alpha1.ClientConfig{
+				Service: &triggersv1alpha1.ServiceReference{
+					Name:      interceptors.CoreInterceptorsHost,
+					Namespace: "tekton-pipelines",
+					Path:      "cel",
+				},
+			},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{
+		ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{cel, celService},
+	}, "my-el", nil)
+	ref := func(name string) *triggersv1beta1.TriggerInterceptor {
+		return &triggersv1beta1.TriggerInterceptor{Ref: triggersv1beta1.InterceptorRef{Name: name, Kind: triggersv1beta1.ClusterInterceptorKind}}
+	}
+	trInt := []*triggersv1beta1.TriggerInterceptor{ref("cel-service"), ref("cel")}
+
+	// The core interceptors are called over HTTP by default
+	want := []DebugInterceptor{
+		{Name: "cel-service", Kind: "ClusterInterceptor", URL: "http://tekton-triggers-core-interceptors.tekton-pipelines.svc:80/cel"},
+		{Name: "cel", Kind: "ClusterInterceptor", URL: "http://tekton-triggers-core-interceptors/cel"},
+	}
+	if diff := cmp.Diff(want, sink.debugInterceptors(trInt, namespace)); diff != "" {
+		t.Errorf("debugInterceptors() (-want,+got): %s", diff)
+	}
+
+	// The core interceptors referenced by their Service run in-process
+	coreInterceptors, err := server.NewWithCoreInterceptors(interceptors.DefaultSecretGetter(sink.KubeClientSet.CoreV1()), sink.Logger)
+	if err != nil {
+		t.Fatalf("error initializing core interceptors: %v", err)
+	}
+	sink.CoreInterceptors = coreInterceptors
+	want[0] = DebugInterceptor{Name: "cel-service", Kind: "ClusterInterceptor", InProcess: true, Path: "cel"}
+	if diff := cmp.Diff(want, sink.debugInterceptors(trInt, namespace)); diff != "" {
+		t.Errorf("debugInterceptors() with in-process interceptors (-want,+got): %s", diff)
+	}
+}
+
+func TestDebugHandler_Disabled(t *testing.T) {
+	sink := Sink{DebugToken: "debug-token"}
+	req := httptest.NewRequest(http.MethodGet, "/debug/triggers", nil)
+	req.Header.Set("Authorization", "Bearer debug-token")
+	rec := httptest.NewRecorder()
+	sink.DebugHandler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want 404", rec.Code)
+	}
+}
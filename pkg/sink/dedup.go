@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+)
+
+// DeliveryStore records the deliveries received by an EventListener so that
+// repeated deliveries of the same event can be skipped.
+type DeliveryStore interface {
+	// Record records the delivery identified by key to the EventListener for
+	// the ttl. If the key was already recorded and has not expired, it returns
+	// the ID of the event the key was first recorded for and true.
+	Record(ctx context.Context, el *triggersv1.EventListener, key, eventID string, ttl time.Duration) (string, bool, error)
+	// Release forgets the delivery identified by key if it is still recorded
+	// for the event, so that the next delivery of the key is processed. It is
+	// used when the processing of the event failed.
+	Release(ctx context.Context, key, eventID string) error
+}
+
+// LeaseDeliveryStore is a DeliveryStore that records each delivery as a Lease
+// in the namespace of the EventListener, so that all of its replicas share
+// the deliveries. The holder of the Lease is the ID of the first event
+// received for the delivery.
+type LeaseDeliveryStore struct {
+	KubeClient             kubernetes.Interface
+	EventListenerName      string
+	EventListenerNamespace string
+
+	now func() time.Time
+}
+
+// NewLeaseDeliveryStore returns a LeaseDeliveryStore for the EventListener.
+func NewLeaseDeliveryStore(kubeClient kubernetes.Interface, elName, elNamespace string) *LeaseDeliveryStore {
+	return &LeaseDeliveryStore{
+		KubeClient:             kubeClient,
+		EventListenerName:      elName,
+		EventListenerNamespace: elNamespace,
+		now:                    time.Now,
+	}
+}
+
+// Record creates a Lease for the key, owned by the EventListener so that it is
+// deleted with it, or takes over the Lease if it expired.
+func (s *LeaseDeliveryStore) Record(ctx context.Context, el *triggersv1.EventListener, key, eventID string, ttl time.Duration) (string, bool, error) {
+	leases := s.KubeClient.CoordinationV1().Leases(s.EventListenerNamespace)
+	now := metav1.NewMicroTime(s.now())
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.leaseName(key),
+			Namespace: s.EventListenerNamespace,
+			Labels: map[string]string{
+				triggers.GroupName + triggers.EventListenerLabelKey: s.EventListenerName,
+				triggers.GroupName + triggers.DeliveryLabelKey:      "true",
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(el)},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(eventID),
+			AcquireTime:          &now,
+			LeaseDurationSeconds: ptr.To(int32(math.Ceil(ttl.Seconds()))),
+		},
+	}
+	_, err := leases.Create(ctx, lease, metav1.CreateOptions{})
+	if err == nil {
+		return eventID, false, nil
+	}
+	if !kerrors.IsAlreadyExists(err) {
+		return "", false, fmt.Errorf("failed to create lease for delivery %s: %w", key, err)
+	}
+
+	existing, err := leases.Get(ctx, lease.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get lease for delivery %s: %w", key, err)
+	}
+	if !s.expired(existing) {
+		return ptr.Deref(existing.Spec.HolderIdentity, ""), true, nil
+	}
+	existing.OwnerReferences = lease.OwnerReferences
+	existing.Spec = lease.Spec
+	if _, err := leases.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		if kerrors.IsConflict(err) {
+			// Another replica took over the expired lease for the same delivery.
+			return s.holder(ctx, lease.Name)
+		}
+		return "", false, fmt.Errorf("failed to update lease for delivery %s: %w", key, err)
+	}
+	return eventID, false, nil
+}
+
+// Release deletes the Lease of the key if the event still holds it.
+func (s *LeaseDeliveryStore) Release(ctx context.Context, key, eventID string) error {
+	leases := s.KubeClient.CoordinationV1().Leases(s.EventListenerNamespace)
+	lease, err := leases.Get(ctx, s.leaseName(key), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get lease for delivery %s: %w", key, err)
+	}
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != eventID {
+		// The lease expired and was taken over by another delivery
+		return nil
+	}
+	err = leases.Delete(ctx, lease.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &lease.ResourceVersion},
+	})
+	if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+		return fmt.Errorf("failed to delete lease for delivery %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LeaseDeliveryStore) holder(ctx context.Context, name string) (string, bool, error) {
+	lease, err := s.KubeClient.CoordinationV1().Leases(s.EventListenerNamespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", false, err
+	}
+	return ptr.Deref(lease.Spec.HolderIdentity, ""), true, nil
+}
+
+// DeleteExpired deletes the Leases of the EventListener's deliveries that expired.
+func (s *LeaseDeliveryStore) DeleteExpired(ctx context.Context) error {
+	leases := s.KubeClient.CoordinationV1().Leases(s.EventListenerNamespace)
+	list, err := leases.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			triggers.GroupName + triggers.EventListenerLabelKey: s.EventListenerName,
+			triggers.GroupName + triggers.DeliveryLabelKey:      "true",
+		}).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range list.Items {
+		lease := &list.Items[i]
+		if !s.expired(lease) {
+			continue
+		}
+		err := leases.Delete(ctx, lease.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{ResourceVersion: &lease.ResourceVersion},
+		})
+		if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsConflict(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *LeaseDeliveryStore) expired(lease *coordinationv1.Lease) bool {
+	if lease.Spec.AcquireTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expires := lease.Spec.AcquireTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return !s.now().Before(expires)
+}
+
+// leaseName hashes the EventListener name and the key since keys may contain
+// characters that are not allowed in names.
+func (s *LeaseDeliveryStore) leaseName(key string) string {
+	sum := sha256.Sum256([]byte(s.EventListenerName + "/" + key))
+	return "el-delivery-" + hex.EncodeToString(sum[:])
+}
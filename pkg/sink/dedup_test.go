@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	fakekubeclient "k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/kmeta"
+)
+
+var deliveryEventListener = &triggersv1beta1.EventListener{
+	ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+}
+
+// newInMemoryDeliveryStore returns a DeliveryStore that keeps the deliveries
+// in memory, for the tests of a single replica.
+func newInMemoryDeliveryStore() DeliveryStore {
+	return &inMemoryDeliveryStore{
+		deliveries: map[string]delivery{},
+		now:        time.Now,
+	}
+}
+
+type delivery struct {
+	eventID string
+	expires time.Time
+}
+
+type inMemoryDeliveryStore struct {
+	mu         sync.Mutex
+	deliveries map[string]delivery
+	now        func() time.Time
+}
+
+func (s *inMemoryDeliveryStore) Record(_ context.Context, _ *triggersv1beta1.EventListener, key, eventID string, ttl time.Duration) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for k, d := range s.deliveries {
+		if !now.Before(d.expires) {
+			delete(s.deliveries, k)
+		}
+	}
+	if d, ok := s.deliveries[key]; ok {
+		return d.eventID, true, nil
+	}
+	s.deliveries[key] = delivery{eventID: eventID, expires: now.Add(ttl)}
+	return eventID, false, nil
+}
+
+func (s *inMemoryDeliveryStore) Release(_ context.Context, key, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d, ok := s.deliveries[key]; ok && d.eventID == eventID {
+		delete(s.deliveries, key)
+	}
+	return nil
+}
+
+type recordCall struct {
+	key, eventID  string
+	advance       time.Duration
+	wantEventID   string
+	wantDuplicate bool
+}
+
+func checkRecords(t *testing.T, store DeliveryStore, clock *time.Time, calls []recordCall) {
+	t.Helper()
+	for i, c := range calls {
+		*clock = clock.Add(c.advance)
+		gotEventID, gotDuplicate, err := store.Record(context.Background(), deliveryEventListener, c.key, c.eventID, time.Minute)
+		if err != nil {
+			t.Fatalf("call %d: Record() returned error: %v", i, err)
+		}
+		if gotEventID != c.wantEventID || gotDuplicate != c.wantDuplicate {
+			t.Errorf("call %d: Record() = (%q, %t), want (%q, %t)", i, gotEventID, gotDuplicate, c.wantEventID, c.wantDuplicate)
+		}
+	}
+}
+
+var deliveryCalls = []recordCall{
+	{key: "delivery-1", eventID: "event-1", wantEventID: "event-1"},
+	{key: "delivery-1", eventID: "event-2", advance: 30 * time.Second, wantEventID: "event-1", wantDuplicate: true},
+	{key: "delivery-2", eventID: "event-3", wantEventID: "event-3"},
+	// delivery-1 expires one minute after it was first recorded
+	{key: "delivery-1", eventID: "event-4", advance: 30 * time.Second, wantEventID: "event-4"},
+	{key: "delivery-1", eventID: "event-5", wantEventID: "event-4", wantDuplicate: true},
+}
+
+// checkRelease checks that a released delivery is recorded again, and that
+// releasing it for another event does not.
+func checkRelease(t *testing.T, store DeliveryStore, clock *time.Time) {
+	t.Helper()
+	checkRecords(t, store, clock, []recordCall{{key: "released", eventID: "event-1", wantEventID: "event-1"}})
+	if err := store.Release(context.Background(), "released", "event-0"); err != nil {
+		t.Fatalf("Release() returned error: %v", err)
+	}
+	checkRecords(t, store, clock, []recordCall{{key: "released", eventID: "event-2", wantEventID: "event-1", wantDuplicate: true}})
+	for range 2 {
+		if err := store.Release(context.Background(), "released", "event-1"); err != nil {
+			t.Fatalf("Release() returned error: %v", err)
+		}
+	}
+	checkRecords(t, store, clock, []recordCall{{key: "released", eventID: "event-3", wantEventID: "event-3"}})
+}
+
+func TestInMemoryDeliveryStore(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := newInMemoryDeliveryStore().(*inMemoryDeliveryStore)
+	store.now = func() time.Time { return clock }
+	checkRecords(t, store, &clock, deliveryCalls)
+	checkRelease(t, store, &clock)
+}
+
+func TestLeaseDeliveryStore(t *testing.T) {
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	kubeClient := fakekubeclient.NewSimpleClientset()
+	store := NewLeaseDeliveryStore(kubeClient, "my-el", namespace)
+	store.now = func() time.Time { return clock }
+	checkRecords(t, store, &clock, deliveryCalls)
+
+	// Replicas share the deliveries
+	other := NewLeaseDeliveryStore(kubeClient, "my-el", namespace)
+	other.now = store.now
+	checkRecords(t, other, &clock, []recordCall{
+		{key: "delivery-1", eventID: "event-6", wantEventID: "event-4", wantDuplicate: true},
+	})
+	// But other EventListeners do not
+	otherEL := NewLeaseDeliveryStore(kubeClient, "other-el", namespace)
+	otherEL.now = store.now
+	checkRecords(t, otherEL, &clock, []recordCall{
+		{key: "delivery-1", eventID: "event-7", wantEventID: "event-7"},
+	})
+
+	checkRelease(t, store, &clock)
+	if err := store.Release(context.Background(), "released", "event-3"); err != nil {
+		t.Fatalf("Release() returned error: %v", err)
+	}
+
+	// delivery-2 has expired, delivery-1 has not
+	clock = clock.Add(45 * time.Second)
+	if err := store.DeleteExpired(context.Background()); err != nil {
+		t.Fatalf("DeleteExpired() returned error: %v", err)
+	}
+	leases, err := kubeClient.CoordinationV1().Leases(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("error listing leases: %v", err)
+	}
+	got := map[string]bool{}
+	for _, l := range leases.Items {
+		got[l.Name] = true
+		// The leases are deleted with the EventListener
+		if diff := cmp.Diff([]metav1.OwnerReference{*kmeta.NewControllerRef(deliveryEventListener)}, l.OwnerReferences); diff != "" {
+			t.Errorf("owner references of lease %s (-want, +got): %s", l.Name, diff)
+		}
+	}
+	want := map[string]bool{
+		store.leaseName("delivery-1"):   true,
+		otherEL.leaseName("delivery-1"): true,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got leases %v, want %v", got, want)
+	}
+	for name := range want {
+		if !got[name] {
+			t.Errorf("lease %s was deleted", name)
+		}
+	}
+}
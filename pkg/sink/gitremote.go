@@ -0,0 +1,362 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The Git sources list the refs of repositories with the upload-pack service
+// of the Git protocol, over HTTP(S) or SSH, so that the EventListener image
+// does not need git or ssh.
+
+const (
+	// gitUploadPack is the service advertising the refs of a repository.
+	gitUploadPack = "git-upload-pack"
+	// gitUserAgent is the User-Agent of the requests of the Git sources.
+	gitUserAgent = "git/tekton-triggers"
+	// maxPktLineSize is the maximum size of a pkt-line, including its length.
+	maxPktLineSize = 65520
+)
+
+// gitHTTPClient is the HTTP client listing the refs of HTTP(S) repositories.
+var gitHTTPClient = http.DefaultClient
+
+// gitAuth holds the credentials of a Git source.
+type gitAuth struct {
+	username string
+	password string
+	// signer and hostKeyCallback authenticate SSH connections
+	signer          ssh.Signer
+	hostKeyCallback ssh.HostKeyCallback
+}
+
+// gitRemote is the location of a repository.
+type gitRemote struct {
+	// ssh is whether the repository is reached over SSH rather than HTTP(S)
+	ssh  bool
+	url  *url.URL
+	path string
+}
+
+// parseGitRemote parses the URL of a Git source, which is either an http,
+// https or ssh URL, or the scp-like syntax of SSH, e.g.
+// git@github.com:tektoncd/triggers.git.
+func parseGitRemote(rawURL string) (*gitRemote, error) {
+	if !strings.Contains(rawURL, "://") {
+		// scp-like syntax, whose path is relative to the home of the user
+		host, p, ok := strings.Cut(rawURL, ":")
+		if !ok || host == "" || p == "" || strings.Contains(host, "/") {
+			return nil, fmt.Errorf("unsupported URL %q, it must be an http, https or ssh URL", rawURL)
+		}
+		u := &url.URL{Scheme: "ssh", Host: host}
+		if user, h, ok := strings.Cut(host, "@"); ok {
+			u.User, u.Host = url.User(user), h
+		}
+		return &gitRemote{ssh: true, url: u, path: p}, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return &gitRemote{url: u, path: u.Path}, nil
+	case "ssh":
+		// ssh://host/~user/repo is relative to the home of the user
+		return &gitRemote{ssh: true, url: u, path: strings.TrimPrefix(u.Path, "/~")}, nil
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme %q, it must be http, https or ssh", u.Scheme)
+	}
+}
+
+// listGitRefs returns the SHAs of the refs advertised by the repository,
+// including the peeled refs of annotated tags, whose names end with ^{}.
+func listGitRefs(ctx context.Context, remote *gitRemote, auth *gitAuth) (map[string]string, error) {
+	if remote.ssh {
+		return listGitRefsSSH(ctx, remote, auth)
+	}
+	return listGitRefsHTTP(ctx, remote, auth)
+}
+
+// listGitRefsHTTP lists the refs with the smart HTTP protocol.
+func listGitRefsHTTP(ctx context.Context, remote *gitRemote, auth *gitAuth) (map[string]string, error) {
+	u := *remote.url
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/info/refs"
+	u.RawQuery = "service=" + gitUploadPack
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("User-Agent", gitUserAgent)
+	if auth != nil && (auth.username != "" || auth.password != "") {
+		request.SetBasicAuth(auth.username, auth.password)
+	}
+	response, err := gitHTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("listing the refs failed with status %d", response.StatusCode)
+	}
+	if ct := response.Header.Get("Content-Type"); ct != "application/x-"+gitUploadPack+"-advertisement" {
+		return nil, fmt.Errorf("the server does not support the smart HTTP protocol, got Content-Type %q", ct)
+	}
+
+	// The advertisement starts with the name of the service
+	line, err := readPktLine(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if string(bytes.TrimSuffix(line, []byte("\n"))) != "# service="+gitUploadPack {
+		return nil, fmt.Errorf("unexpected advertisement %q", line)
+	}
+	for line != nil {
+		if line, err = readPktLine(response.Body); err != nil {
+			return nil, err
+		}
+	}
+	return readGitRefs(response.Body)
+}
+
+// listGitRefsSSH lists the refs by running git-upload-pack over SSH.
+func listGitRefsSSH(ctx context.Context, remote *gitRemote, auth *gitAuth) (map[string]string, error) {
+	if auth == nil || auth.signer == nil {
+		return nil, errors.New("SSH sources require a Secret holding the ssh-privatekey and known_hosts keys")
+	}
+	user := "git"
+	if remote.url.User != nil && remote.url.User.Username() != "" {
+		user = remote.url.User.Username()
+	}
+	port := remote.url.Port()
+	if port == "" {
+		port = "22"
+	}
+	addr := net.JoinHostPort(remote.url.Hostname(), port)
+	config := &ssh.ClientConfig{
+		User:              user,
+		Auth:              []ssh.AuthMethod{ssh.PublicKeys(auth.signer)},
+		HostKeyCallback:   auth.hostKeyCallback,
+		HostKeyAlgorithms: knownHostKeyAlgorithms(auth.hostKeyCallback, addr),
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	// Closing the connection interrupts the SSH session when the context is done
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	client := ssh.NewClient(c, chans, reqs)
+	defer client.Close()
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	session.Stderr = &stderr
+	if err := session.Start(gitUploadPack + " " + shellQuote(remote.path)); err != nil {
+		return nil, err
+	}
+	refs, err := readGitRefs(stdout)
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	// A flush packet tells the server that nothing is fetched
+	_, _ = io.WriteString(stdin, "0000")
+	stdin.Close()
+	return refs, nil
+}
+
+// knownHostKeyAlgorithms returns the algorithms of the known keys of the
+// address, so that the server offers a key that can be verified.
+func knownHostKeyAlgorithms(callback ssh.HostKeyCallback, addr string) []string {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil
+	}
+	tcpAddr := &net.TCPAddr{IP: net.IPv4zero}
+	var keyErr *knownhosts.KeyError
+	if !errors.As(callback(addr, tcpAddr, signer.PublicKey()), &keyErr) {
+		return nil
+	}
+	var algorithms []string
+	for _, k := range keyErr.Want {
+		switch t := k.Key.Type(); t {
+		case ssh.KeyAlgoRSA:
+			algorithms = append(algorithms, ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, t)
+		default:
+			algorithms = append(algorithms, t)
+		}
+	}
+	return algorithms
+}
+
+// shellQuote quotes the argument for the shell of the server, as git does.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// readGitRefs reads the refs advertised by upload-pack, up to a flush packet.
+func readGitRefs(r io.Reader) (map[string]string, error) {
+	refs := map[string]string{}
+	for {
+		line, err := readPktLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if line == nil {
+			return refs, nil
+		}
+		if msg, ok := bytes.CutPrefix(line, []byte("ERR ")); ok {
+			return nil, fmt.Errorf("the server failed to list the refs: %s", bytes.TrimSpace(msg))
+		}
+		// The first ref is followed by the capabilities of the server
+		line, _, _ = bytes.Cut(bytes.TrimSuffix(line, []byte("\n")), []byte{0})
+		sha, ref, ok := strings.Cut(string(line), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid ref advertisement %q", line)
+		}
+		// Empty repositories advertise their capabilities on a placeholder
+		if ref != "capabilities^{}" {
+			refs[ref] = sha
+		}
+	}
+}
+
+// readPktLine reads a pkt-line of the Git protocol, returning nil for a flush
+// packet.
+func readPktLine(r io.Reader) ([]byte, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, fmt.Errorf("failed to read the ref advertisement: %w", err)
+	}
+	n, err := strconv.ParseUint(string(length[:]), 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pkt-line length %q", length)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	if n < 4 || n > maxPktLineSize {
+		return nil, fmt.Errorf("invalid pkt-line length %d", n)
+	}
+	line := make([]byte, n-4)
+	if _, err := io.ReadFull(r, line); err != nil {
+		return nil, fmt.Errorf("failed to read the ref advertisement: %w", err)
+	}
+	return line, nil
+}
+
+// sshKnownHostsKey is the key of the known hosts of the server in the SSH
+// Secrets of git sources.
+const sshKnownHostsKey = "known_hosts"
+
+// gitAuth returns the credentials of the Secret of a Git source, or nil if it
+// has none. SSH credentials require the known hosts of the server.
+func (r Sink) gitAuth(ctx context.Context, secretName string) (*gitAuth, error) {
+	if secretName == "" {
+		return nil, nil
+	}
+	secret, err := r.KubeClientSet.CoreV1().Secrets(r.EventListenerNamespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the credentials of the source: %w", err)
+	}
+
+	if key, ok := secret.Data[corev1.SSHAuthPrivateKey]; ok {
+		// The key of the server is always verified, so that the credentials
+		// are not sent to another server
+		knownHosts := secret.Data[sshKnownHostsKey]
+		if len(knownHosts) == 0 {
+			return nil, fmt.Errorf("secret %s holds the %s key without the %s key verifying the key of the server", secretName, corev1.SSHAuthPrivateKey, sshKnownHostsKey)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s key of secret %s: %w", corev1.SSHAuthPrivateKey, secretName, err)
+		}
+		callback, err := parseKnownHosts(knownHosts)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s key of secret %s: %w", sshKnownHostsKey, secretName, err)
+		}
+		return &gitAuth{signer: signer, hostKeyCallback: callback}, nil
+	}
+
+	username, password := secret.Data[corev1.BasicAuthUsernameKey], secret.Data[corev1.BasicAuthPasswordKey]
+	if len(username) == 0 && len(password) == 0 {
+		return nil, fmt.Errorf("secret %s holds neither the %s key nor the %s and %s keys", secretName, corev1.SSHAuthPrivateKey, corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey)
+	}
+	return &gitAuth{username: string(username), password: string(password)}, nil
+}
+
+// parseKnownHosts returns the callback verifying the host keys listed in the
+// known_hosts format. knownhosts only reads files, so the hosts are written
+// to a temporary file.
+func parseKnownHosts(knownHosts []byte) (ssh.HostKeyCallback, error) {
+	f, err := os.CreateTemp("", "known-hosts-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(knownHosts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return knownhosts.New(f.Name())
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// GitEventSource is the EventSourceHeader of the events of Git sources.
+	GitEventSource = "git"
+	// zeroSHA is the before SHA of created refs, and the after SHA of deleted refs.
+	zeroSHA = "0000000000000000000000000000000000000000"
+)
+
+var (
+	// gitSourceTick is how often the EventListener checks whether Git sources
+	// must be polled.
+	gitSourceTick = 10 * time.Second
+	// gitPollTimeout bounds each listing of the refs of a repository.
+	gitPollTimeout = time.Minute
+)
+
+// gitSourceState is the state of a Git source recorded in the ConfigMap of
+// the Git sources of the EventListener.
+type gitSourceState struct {
+	URL  string            `json:"url"`
+	Refs map[string]string `json:"refs"`
+}
+
+// GitPushEvent is the event generated when a ref watched by a Git source is
+// created, updated or deleted. Its fields follow the push events of GitHub.
+type GitPushEvent struct {
+	Source     string        `json:"source"`
+	Repository GitRepository `json:"repository"`
+	Ref        string        `json:"ref"`
+	Before     string        `json:"before"`
+	After      string        `json:"after"`
+	Created    bool          `json:"created"`
+	Deleted    bool          `json:"deleted"`
+}
+
+// GitRepository is the repository of a GitPushEvent.
+type GitRepository struct {
+	URL string `json:"url"`
+}
+
+// RunGitSources polls the Git sources of the EventListener until the context
+// is done. The refs seen by each source are recorded in a ConfigMap, whose
+// updates ensure that a single replica generates the events of each change.
+func (r Sink) RunGitSources(ctx context.Context) {
+	ticker := time.NewTicker(gitSourceTick)
+	defer ticker.Stop()
+	polled := map[string]time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.pollGitSources(ctx, polled, now)
+		}
+	}
+}
+
+// pollGitSources polls the Git sources whose interval elapsed since they
+// were last polled.
+func (r Sink) pollGitSources(ctx context.Context, polled map[string]time.Time, now time.Time) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		r.Logger.Errorf("Error getting EventListener %s in Namespace %s: %s", r.EventListenerName, r.EventListenerNamespace, err)
+		return
+	}
+	for _, s := range el.Spec.Sources {
+		if s.Git == nil || now.Sub(polled[s.Name]) < s.Git.GetInterval() {
+			continue
+		}
+		polled[s.Name] = now
+		if err := r.pollGitSource(ctx, el, s.Name, s.Git); err != nil {
+			r.Logger.With(zap.String("source", s.Name)).Errorf("failed to poll %s: %v", s.Git.URL, err)
+		}
+	}
+}
+
+// pollGitSource lists the watched refs of the Git source, and generates a push
+// event for each ref that changed since the previous poll. The first poll of a
+// source only records its refs.
+func (r Sink) pollGitSource(ctx context.Context, el *triggersv1.EventListener, name string, g *triggersv1.GitSource) error {
+	log := r.Logger.With(zap.String("source", name))
+	refs, err := r.lsRemote(ctx, g)
+	if err != nil {
+		return err
+	}
+
+	configMaps := r.KubeClientSet.CoreV1().ConfigMaps(r.EventListenerNamespace)
+	cm, err := configMaps.Get(ctx, gitSourcesConfigMapName(r.EventListenerName), metav1.GetOptions{})
+	exists := err == nil
+	if kerrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      gitSourcesConfigMapName(r.EventListenerName),
+				Namespace: r.EventListenerNamespace,
+				Labels: map[string]string{
+					triggers.GroupName + triggers.EventListenerLabelKey: r.EventListenerName,
+				},
+				OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(el)},
+			},
+		}
+	} else if err != nil {
+		return err
+	}
+
+	var previous gitSourceState
+	if data, ok := cm.Data[name]; ok {
+		if err := json.Unmarshal([]byte(data), &previous); err != nil {
+			log.Warnf("ignoring the invalid state of the source: %v", err)
+		}
+	}
+	var events []GitPushEvent
+	if previous.URL == g.URL {
+		events = gitPushEvents(name, g.URL, previous.Refs, refs)
+		if len(events) == 0 {
+			return nil
+		}
+	} else {
+		log.Infof("recording the %d refs of %s", len(refs), g.URL)
+	}
+
+	state, err := json.Marshal(gitSourceState{URL: g.URL, Refs: refs})
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[name] = string(state)
+	if !exists {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	} else {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	}
+	if kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err) {
+		// Another replica recorded the changes first
+		log.Debugf("the changes of %s were recorded by another replica", g.URL)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		r.fireGitPushEvent(ctx, e)
+	}
+	return nil
+}
+
+// gitSourcesConfigMapName returns the name of the ConfigMap recording the refs
+// seen by the Git sources of the EventListener.
+func gitSourcesConfigMapName(elName string) string {
+	return "el-" + elName + "-git-sources"
+}
+
+// gitPushEvents returns the push events of the refs that were created, updated
+// or deleted between the previous and current refs, sorted by ref.
+func gitPushEvents(source, url string, previous, current map[string]string) []GitPushEvent {
+	var events []GitPushEvent
+	for ref, after := range current {
+		if before, ok := previous[ref]; !ok {
+			events = append(events, GitPushEvent{Ref: ref, Before: zeroSHA, After: after, Created: true})
+		} else if before != after {
+			events = append(events, GitPushEvent{Ref: ref, Before: before, After: after})
+		}
+	}
+	for ref, before := range previous {
+		if _, ok := current[ref]; !ok {
+			events = append(events, GitPushEvent{Ref: ref, Before: before, After: zeroSHA, Deleted: true})
+		}
+	}
+	for i := range events {
+		events[i].Source = source
+		events[i].Repository.URL = url
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Ref < events[j].Ref })
+	return events
+}
+
+// lsRemote returns the SHAs of the refs of the Git source matching its
+// patterns. Annotated tags are resolved to the commit they point to.
+func (r Sink) lsRemote(ctx context.Context, g *triggersv1.GitSource) (map[string]string, error) {
+	remote, err := parseGitRemote(g.URL)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := r.gitAuth(ctx, g.SecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gitPollTimeout)
+	defer cancel()
+	advertised, err := listGitRefs(ctx, remote, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the refs of the repository: %w", err)
+	}
+
+	patterns := g.GetRefs()
+	refs := map[string]string{}
+	peeled := map[string]string{}
+	for ref, sha := range advertised {
+		if tag, ok := strings.CutSuffix(ref, "^{}"); ok {
+			peeled[tag] = sha
+			continue
+		}
+		for _, p := range patterns {
+			if matched, _ := path.Match(p, ref); matched {
+				refs[ref] = sha
+				break
+			}
+		}
+	}
+	for ref, sha := range peeled {
+		if _, ok := refs[ref]; ok {
+			refs[ref] = sha
+		}
+	}
+	return refs, nil
+}
+
+// fireGitPushEvent processes the push event like the events received by the
+// EventListener on the /sources/<name> path.
+func (r Sink) fireGitPushEvent(ctx context.Context, e GitPushEvent) {
+	log := r.Logger.With(zap.String("source", e.Source))
+	body, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf("failed to generate the push event of %s: %v", e.Ref, err)
+		return
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/sources/"+e.Source, bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("failed to generate the push event of %s: %v", e.Ref, err)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventSourceHeader, GitEventSource)
+	log.Infof("generating the push event of %s: %s..%s", e.Ref, e.Before, e.After)
+
+	response := &discardResponseWriter{header: http.Header{}}
+	r.HandleEvent(response, request)
+	if response.status >= http.StatusBadRequest {
+		log.Errorf("the push event of %s was rejected with status %d", e.Ref, response.status)
+	}
+}
@@ -0,0 +1,389 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+// advertiseGitRefs returns the ref advertisement of upload-pack, in pkt-lines.
+func advertiseGitRefs(refs ...string) string {
+	var b strings.Builder
+	for i, ref := range refs {
+		if i == 0 {
+			ref += "\x00multi_ack side-band-64k"
+		}
+		fmt.Fprintf(&b, "%04x%s\n", len(ref)+5, ref)
+	}
+	return b.String() + "0000"
+}
+
+// gitHTTPServer serves the refs returned by refs with the smart HTTP protocol.
+func gitHTTPServer(t *testing.T, refs func() []string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/team/app.git/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+			http.NotFound(w, r)
+			return
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-git-upload-pack-advertisement")
+		fmt.Fprint(w, "001e# service=git-upload-pack\n0000"+advertiseGitRefs(refs()...))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPollGitSource(t *testing.T) {
+	first, second, third, tag := strings.Repeat("1", 40), strings.Repeat("2", 40), strings.Repeat("3", 40), strings.Repeat("a", 40)
+	var advertised atomic.Value
+	advertised.Store([]string{
+		first + " HEAD",
+		first + " refs/heads/main",
+		"4444444444444444444444444444444444444444 refs/pull/1/head",
+		tag + " refs/tags/v1",
+		first + " refs/tags/v1^{}",
+	})
+	srv := gitHTTPServer(t, func() []string { return advertised.Load().([]string) })
+
+	url := srv.URL + "/team/app.git"
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "push-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.ref)")},
+					{Name: "name", Value: ptr.String("$(body.after)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+				Match:    &triggersv1beta1.RequestMatch{Path: "/sources/internal-git"},
+			}},
+			Sources: []triggersv1beta1.EventSource{{
+				Name: "internal-git",
+				Git: &triggersv1beta1.GitSource{
+					URL:        url,
+					Refs:       []string{"refs/heads/*", "refs/tags/v*"},
+					SecretName: "basic-auth",
+				},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners: []*triggersv1beta1.EventListener{el},
+		Secrets: []*corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: namespace},
+			Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+		}},
+	}, el.Name, nil)
+	poll := func() {
+		t.Helper()
+		if err := sink.pollGitSource(context.Background(), el, "internal-git", el.Spec.Sources[0].Git); err != nil {
+			t.Fatalf("pollGitSource() = %v", err)
+		}
+		sink.WGProcessTriggers.Wait()
+	}
+
+	// The first poll records the refs without generating events
+	poll()
+	if actions := dynamicClient.Actions(); len(actions) != 0 {
+		t.Errorf("got actions %v, want none on the first poll", actions)
+	}
+	cm, err := sink.KubeClientSet.CoreV1().ConfigMaps(namespace).Get(context.Background(), "el-my-el-git-sources", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the refs of the source were not recorded: %v", err)
+	}
+	var state gitSourceState
+	if err := json.Unmarshal([]byte(cm.Data["internal-git"]), &state); err != nil {
+		t.Fatalf("error decoding the state of the source: %v", err)
+	}
+	want := gitSourceState{URL: url, Refs: map[string]string{"refs/heads/main": first, "refs/tags/v1": first}}
+	if diff := cmp.Diff(want, state); diff != "" {
+		t.Errorf("recorded state (-want, +got): %s", diff)
+	}
+
+	// Updated, created and deleted refs each generate a push event
+	advertised.Store([]string{
+		second + " HEAD",
+		third + " refs/heads/feature",
+		second + " refs/heads/main",
+	})
+	poll()
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	for name, ref := range map[string]string{second: "refs/heads/main", third: "refs/heads/feature", zeroSHA: "refs/tags/v1"} {
+		tr, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("the TaskRun of the push event of %s was not created: %v", ref, err)
+		}
+		params, _, _ := unstructured.NestedSlice(tr.Object, "spec", "params")
+		wantParams := []interface{}{
+			map[string]interface{}{"name": "url", "value": url},
+			map[string]interface{}{"name": "git-revision", "value": ref},
+		}
+		if diff := cmp.Diff(wantParams, params); diff != "" {
+			t.Errorf("params of the TaskRun of %s (-want, +got): %s", ref, diff)
+		}
+	}
+
+	// Polling again without changes does not generate events
+	creates := len(dynamicClient.Actions())
+	poll()
+	if got := len(dynamicClient.Actions()); got != creates {
+		t.Errorf("got %d more actions, want no event without changes", got-creates)
+	}
+}
+
+func TestGitPushEvents(t *testing.T) {
+	got := gitPushEvents("src", "url",
+		map[string]string{"refs/heads/main": "a", "refs/heads/old": "b", "refs/heads/same": "c"},
+		map[string]string{"refs/heads/main": "d", "refs/heads/new": "e", "refs/heads/same": "c"})
+	repo := GitRepository{URL: "url"}
+	want := []GitPushEvent{
+		{Source: "src", Repository: repo, Ref: "refs/heads/main", Before: "a", After: "d"},
+		{Source: "src", Repository: repo, Ref: "refs/heads/new", Before: zeroSHA, After: "e", Created: true},
+		{Source: "src", Repository: repo, Ref: "refs/heads/old", Before: "b", After: zeroSHA, Deleted: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("gitPushEvents() (-want, +got): %s", diff)
+	}
+}
+
+func TestParseGitRemote(t *testing.T) {
+	for _, tc := range []struct {
+		url     string
+		wantSSH bool
+		want    string
+		path    string
+	}{
+		{url: "https://github.com/tektoncd/triggers.git", want: "https://github.com/tektoncd/triggers.git", path: "/tektoncd/triggers.git"},
+		{url: "ssh://git@example.com:2222/team/app.git", wantSSH: true, want: "ssh://git@example.com:2222/team/app.git", path: "/team/app.git"},
+		{url: "ssh://example.com/~user/app.git", wantSSH: true, want: "ssh://example.com/~user/app.git", path: "user/app.git"},
+		{url: "git@github.com:tektoncd/triggers.git", wantSSH: true, want: "ssh://git@github.com", path: "tektoncd/triggers.git"},
+	} {
+		t.Run(tc.url, func(t *testing.T) {
+			got, err := parseGitRemote(tc.url)
+			if err != nil {
+				t.Fatalf("parseGitRemote() = %v", err)
+			}
+			if got.ssh != tc.wantSSH || got.url.String() != tc.want || got.path != tc.path {
+				t.Errorf("parseGitRemote() = %v, %s, %s, want %v, %s, %s", got.ssh, got.url, got.path, tc.wantSSH, tc.want, tc.path)
+			}
+		})
+	}
+
+	for _, url := range []string{"file:///srv/app.git", "git://example.com/app.git", "/srv/app.git"} {
+		if _, err := parseGitRemote(url); err == nil {
+			t.Errorf("parseGitRemote(%q) should fail", url)
+		}
+	}
+}
+
+// newSSHSigner returns a new ed25519 signer and its private key in PEM.
+func newSSHSigner(t *testing.T) (ssh.Signer, []byte) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer, pem.EncodeToMemory(block)
+}
+
+// gitSSHServer runs upload-pack commands with the host key, for the client
+// key, advertising the refs. It returns its address and the commands it ran.
+func gitSSHServer(t *testing.T, hostKey ssh.Signer, clientKey ssh.PublicKey, refs ...string) (string, <-chan string) {
+	t.Helper()
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, errors.New("unknown key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	commands := make(chan string, 10)
+	serve := func(conn net.Conn) {
+		defer conn.Close()
+		_, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(reqs)
+		for newChannel := range chans {
+			channel, requests, err := newChannel.Accept()
+			if err != nil {
+				return
+			}
+			for req := range requests {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				var exec struct{ Command string }
+				_ = ssh.Unmarshal(req.Payload, &exec)
+				commands <- exec.Command
+				_ = req.Reply(true, nil)
+				_, _ = io.WriteString(channel, advertiseGitRefs(refs...))
+				// Wait for the flush packet of the client
+				var flush [4]byte
+				_, _ = io.ReadFull(channel, flush[:])
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				channel.Close()
+			}
+		}
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn)
+		}
+	}()
+	return l.Addr().String(), commands
+}
+
+func TestListGitRefsSSH(t *testing.T) {
+	hostKey, _ := newSSHSigner(t)
+	clientKey, clientPEM := newSSHSigner(t)
+	sha := strings.Repeat("1", 40)
+	addr, commands := gitSSHServer(t, hostKey, clientKey.PublicKey(), sha+" refs/heads/main")
+	knownHosts := knownhosts.Line([]string{knownhosts.Normalize(addr)}, hostKey.PublicKey())
+
+	otherHostKey, _ := newSSHSigner(t)
+	sink, _ := getSinkAssets(t, test.Resources{Secrets: []*corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-auth", Namespace: namespace},
+		Data:       map[string][]byte{"ssh-privatekey": clientPEM, "known_hosts": []byte(knownHosts + "\n")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "other-host", Namespace: namespace},
+		Data: map[string][]byte{
+			"ssh-privatekey": clientPEM,
+			"known_hosts":    []byte(knownhosts.Line([]string{knownhosts.Normalize(addr)}, otherHostKey.PublicKey()) + "\n"),
+		},
+	}}}, "my-el", nil)
+
+	url := "ssh://git@" + addr + "/team/app's.git"
+	refs, err := sink.lsRemote(context.Background(), &triggersv1beta1.GitSource{URL: url, SecretName: "ssh-auth"})
+	if err != nil {
+		t.Fatalf("lsRemote() = %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"refs/heads/main": sha}, refs); diff != "" {
+		t.Errorf("lsRemote() (-want, +got): %s", diff)
+	}
+	if got, want := <-commands, `git-upload-pack '/team/app'\''s.git'`; got != want {
+		t.Errorf("got command %s, want %s", got, want)
+	}
+
+	// The key of the server must be known
+	if _, err := sink.lsRemote(context.Background(), &triggersv1beta1.GitSource{URL: url, SecretName: "other-host"}); err == nil {
+		t.Error("lsRemote() should fail for an unknown host key")
+	}
+	if _, err := sink.lsRemote(context.Background(), &triggersv1beta1.GitSource{URL: url}); err == nil {
+		t.Error("lsRemote() should fail for an SSH source without credentials")
+	}
+}
+
+func TestGitAuth(t *testing.T) {
+	_, clientPEM := newSSHSigner(t)
+	sink, _ := getSinkAssets(t, test.Resources{Secrets: []*corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic-auth", Namespace: namespace},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-auth", Namespace: namespace},
+		Data:       map[string][]byte{"ssh-privatekey": clientPEM, "known_hosts": []byte("github.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-auth-without-known-hosts", Namespace: namespace},
+		Data:       map[string][]byte{"ssh-privatekey": clientPEM},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid-ssh-key", Namespace: namespace},
+		Data:       map[string][]byte{"ssh-privatekey": []byte("key"), "known_hosts": []byte("hosts")},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: namespace},
+	}}}, "my-el", nil)
+
+	auth, err := sink.gitAuth(context.Background(), "basic-auth")
+	if err != nil {
+		t.Fatalf("gitAuth() = %v", err)
+	}
+	if auth.username != "user" || auth.password != "pass" {
+		t.Errorf("got credentials %s:%s, want user:pass", auth.username, auth.password)
+	}
+
+	auth, err = sink.gitAuth(context.Background(), "ssh-auth")
+	if err != nil {
+		t.Fatalf("gitAuth() = %v", err)
+	}
+	if auth.signer == nil || auth.hostKeyCallback == nil {
+		t.Errorf("got %+v, want an SSH key verifying the known hosts", auth)
+	}
+
+	for _, name := range []string{"ssh-auth-without-known-hosts", "invalid-ssh-key", "empty"} {
+		if _, err := sink.gitAuth(context.Background(), name); err == nil {
+			t.Errorf("gitAuth() should fail for Secret %s", name)
+		}
+	}
+}
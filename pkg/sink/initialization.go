@@ -19,6 +19,7 @@ package sink
 import (
 	"context"
 	"flag"
+	"strings"
 	"time"
 
 	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
@@ -71,7 +72,41 @@ var (
 		"The filename for the TLS key.")
 	payloadValidation = flag.Bool("payload-validation", true,
 		"Whether to disable payload validation or not.")
-	cloudEventURI = flag.String("cloudevent-uri", "", "uri for cloudevent")
+	cloudEventURI       = flag.String("cloudevent-uri", "", "uri for cloudevent")
+	syncResponseTimeout = flag.Int64("sync-response-timeout", 4,
+		"The time to wait for triggers to be processed when a synchronous response is requested.")
+	maxConcurrency = flag.Int("max-concurrency", 0,
+		"The maximum number of triggers and trigger groups processed at the same time. Unlimited if 0.")
+	queueSize = flag.Int("queue-size", 100,
+		"The maximum number of triggers and trigger groups waiting to be processed when max-concurrency is set.")
+	retryAfter = flag.Int64("retry-after", 10,
+		"The delay in seconds sent in the Retry-After header when an event is rejected because the queue is full.")
+	replayMaxEvents = flag.Int("replay-max-events", 0,
+		"The number of recent events kept to be replayed through the admin endpoint. Disabled if 0.")
+	replayRedactHeaders = flag.String("replay-redact-headers", "",
+		"A comma separated list of headers redacted from the events listed by the admin endpoint.")
+	maxBodySize = flag.Int64("max-body-size", 0,
+		"The maximum size in bytes of the body of a request, before and after its decompression. Unlimited if 0.")
+	shutdownGracePeriod = flag.Int64("shutdown-grace-period", 25,
+		"The time in seconds to wait for the events being processed when the EventListener is terminated.")
+	shutdownDelay = flag.Int64("shutdown-delay", 15,
+		"The time in seconds to keep accepting requests when the EventListener is terminated, while it fails its readiness probe.")
+	debugMaxDecisions = flag.Int("debug-max-decisions", 0,
+		"The number of recent decisions kept for each trigger and listed by the debug endpoints. Disabled if 0.")
+	authentication = flag.Bool("authentication", false,
+		"Whether requests must be authenticated before they are processed.")
+	authAPIKeyHeader = flag.String("auth-api-key-header", "",
+		"The header holding the API keys accepted when authentication is enabled.")
+	authTokenReview = flag.Bool("auth-token-review", false,
+		"Whether Kubernetes bearer tokens are authenticated with the TokenReview API.")
+	authTokenReviewAudiences = flag.String("auth-token-review-audiences", "",
+		"A comma separated list of the audiences the Kubernetes bearer tokens must be issued for.")
+	authTokenReviewUsers = flag.String("auth-token-review-users", "",
+		"A comma separated list of the users allowed to send requests with Kubernetes bearer tokens. All users if empty.")
+	inProcessInterceptors = flag.Bool("in-process-interceptors", false,
+		"Whether the core interceptors run in the EventListener process rather than in the core interceptors service.")
+	authOverride = flag.String("auth-override", AuthOverrideImpersonation,
+		"How the resources of the triggers with a service account are created, either impersonation or tokenrequest.")
 )
 
 // Args define the arguments for Sink.
@@ -110,6 +145,40 @@ type Args struct {
 	PayloadValidation bool
 	// CloudEventURI refers to the location where cloudevent data need to be send
 	CloudEventURI string
+	// SyncResponseTimeout defines how long to wait for triggers when a synchronous response is requested
+	SyncResponseTimeout time.Duration
+	// MaxConcurrency is the maximum number of triggers and trigger groups processed at the same time
+	MaxConcurrency int
+	// QueueSize is the maximum number of triggers and trigger groups waiting to be processed
+	QueueSize int
+	// RetryAfter is the delay sent in the Retry-After header of rejected events
+	RetryAfter time.Duration
+	// ReplayMaxEvents is the number of recent events kept to be replayed
+	ReplayMaxEvents int
+	// ReplayRedactHeaders are the headers redacted from the events listed by the admin endpoint
+	ReplayRedactHeaders []string
+	// MaxBodySize is the maximum size in bytes of the body of a request
+	MaxBodySize int64
+	// ShutdownGracePeriod defines how long to wait for the events being processed on shutdown
+	ShutdownGracePeriod time.Duration
+	// ShutdownDelay defines how long to keep accepting requests on shutdown, while failing the readiness probe
+	ShutdownDelay time.Duration
+	// DebugMaxDecisions is the number of recent decisions kept for each trigger
+	DebugMaxDecisions int
+	// Authentication defines whether requests must be authenticated
+	Authentication bool
+	// AuthAPIKeyHeader is the header holding the API keys accepted
+	AuthAPIKeyHeader string
+	// AuthTokenReview defines whether Kubernetes bearer tokens are authenticated with the TokenReview API
+	AuthTokenReview bool
+	// AuthTokenReviewAudiences are the audiences the Kubernetes bearer tokens must be issued for
+	AuthTokenReviewAudiences []string
+	// AuthTokenReviewUsers are the users allowed to send requests with Kubernetes bearer tokens
+	AuthTokenReviewUsers []string
+	// AuthOverride defines how the triggers authenticate as their service account
+	AuthOverride string
+	// InProcessInterceptors defines whether the core interceptors run in the EventListener process
+	InProcessInterceptors bool
 }
 
 // Clients define the set of client dependencies Sink requires.
@@ -133,6 +202,9 @@ func GetArgs() (Args, error) {
 	if *portFlag == "" {
 		return Args{}, xerrors.Errorf("-%s arg not found", port)
 	}
+	if *authOverride != AuthOverrideImpersonation && *authOverride != AuthOverrideTokenRequest {
+		return Args{}, xerrors.Errorf("-auth-override must be %s or %s, got %q", AuthOverrideImpersonation, AuthOverrideTokenRequest, *authOverride)
+	}
 
 	return Args{
 		ElName:                            *nameFlag,
@@ -152,9 +224,33 @@ func GetArgs() (Args, error) {
 		Cert:                              *tlsCertFlag,
 		Key:                               *tlsKeyFlag,
 		CloudEventURI:                     *cloudEventURI,
+		SyncResponseTimeout:               time.Duration(*syncResponseTimeout),
+		MaxConcurrency:                    *maxConcurrency,
+		QueueSize:                         *queueSize,
+		RetryAfter:                        time.Duration(*retryAfter),
+		ReplayMaxEvents:                   *replayMaxEvents,
+		ReplayRedactHeaders:               splitList(*replayRedactHeaders),
+		MaxBodySize:                       *maxBodySize,
+		ShutdownGracePeriod:               time.Duration(*shutdownGracePeriod) * time.Second,
+		ShutdownDelay:                     time.Duration(*shutdownDelay) * time.Second,
+		DebugMaxDecisions:                 *debugMaxDecisions,
+		Authentication:                    *authentication,
+		AuthAPIKeyHeader:                  *authAPIKeyHeader,
+		AuthTokenReview:                   *authTokenReview,
+		AuthTokenReviewAudiences:          splitList(*authTokenReviewAudiences),
+		AuthTokenReviewUsers:              splitList(*authTokenReviewUsers),
+		AuthOverride:                      *authOverride,
+		InProcessInterceptors:             *inProcessInterceptors,
 	}, nil
 }
 
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
 // ConfigureClients returns the kubernetes and triggers clientsets
 func ConfigureClients(ctx context.Context, clusterConfig *rest.Config) (Clients, error) {
 	kubeClient, err := kubeclientset.NewForConfig(clusterConfig)
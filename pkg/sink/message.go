@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+var (
+	// messageSourceTick is how often the EventListener starts and stops its
+	// message sources when they change.
+	messageSourceTick = 10 * time.Second
+	// messageSourceRetryDelay is how long a message source waits before
+	// receiving messages again after it failed.
+	messageSourceRetryDelay = 10 * time.Second
+)
+
+type messageKey struct{}
+
+// withMessage marks the request as a message of a message source.
+func withMessage(ctx context.Context) context.Context {
+	return context.WithValue(ctx, messageKey{}, true)
+}
+
+// isMessage returns true if the request is a message of a message source.
+// The sink waits for the Triggers of a message to be processed until the
+// context of its source is done, rather than for the SyncResponseTimeout, so
+// that the message is only acknowledged once they were processed.
+func isMessage(ctx context.Context) bool {
+	message, _ := ctx.Value(messageKey{}).(bool)
+	return message
+}
+
+// MessageSource receives the messages of a message bus, such as NATS, so that
+// the EventListener processes them like the events it receives.
+type MessageSource interface {
+	// Receive passes each message to process until the context is done. The
+	// messages for which process returns an error are redelivered if the
+	// message bus supports it.
+	Receive(ctx context.Context, process ProcessMessageFunc) error
+}
+
+// ProcessMessageFunc processes the body and headers of a message. If wait is
+// true, it returns once the Triggers and TriggerGroups it matches were
+// processed, so that the message can be acknowledged or redelivered.
+// Otherwise it returns once they were accepted.
+type ProcessMessageFunc func(ctx context.Context, body []byte, header http.Header, wait bool) error
+
+// newMessageSource returns the MessageSource of the EventSource and the
+// EventSourceHeader of its events, or nil if the EventSource does not receive
+// messages.
+func (r Sink) newMessageSource(ctx context.Context, s triggersv1.EventSource) (MessageSource, string, error) {
+	switch {
+	case s.NATS != nil:
+		source, err := r.newNATSSource(ctx, s.Name, s.NATS)
+		return source, NATSEventSource, err
+	default:
+		return nil, "", nil
+	}
+}
+
+// runningSource is a message source receiving messages.
+type runningSource struct {
+	spec   triggersv1.EventSource
+	cancel context.CancelFunc
+}
+
+// RunMessageSources receives the messages of the message sources of the
+// EventListener until the context is done, and returns once the sources
+// stopped.
+func (r Sink) RunMessageSources(ctx context.Context) {
+	running := map[string]*runningSource{}
+	var receiving sync.WaitGroup
+	defer func() {
+		for _, s := range running {
+			s.cancel()
+		}
+		receiving.Wait()
+	}()
+	ticker := time.NewTicker(messageSourceTick)
+	defer ticker.Stop()
+	for {
+		r.syncMessageSources(ctx, running, &receiving)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncMessageSources starts the new message sources, and stops the message
+// sources that changed or were removed.
+func (r Sink) syncMessageSources(ctx context.Context, running map[string]*runningSource, receiving *sync.WaitGroup) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		r.Logger.Errorf("Error getting EventListener %s in Namespace %s: %s", r.EventListenerName, r.EventListenerNamespace, err)
+		return
+	}
+	wanted := map[string]triggersv1.EventSource{}
+	for _, s := range el.Spec.Sources {
+		wanted[s.Name] = s
+	}
+	for name, s := range running {
+		if spec, ok := wanted[name]; !ok || !equality.Semantic.DeepEqual(spec, s.spec) {
+			s.cancel()
+			delete(running, name)
+		}
+	}
+	for name, spec := range wanted {
+		if _, ok := running[name]; ok {
+			continue
+		}
+		log := r.Logger.With(zap.String("source", name))
+		source, sourceType, err := r.newMessageSource(ctx, spec)
+		if err != nil {
+			log.Errorf("failed to start the source: %v", err)
+			continue
+		}
+		if source == nil {
+			continue
+		}
+		sourceCtx, cancel := context.WithCancel(ctx)
+		running[name] = &runningSource{spec: *spec.DeepCopy(), cancel: cancel}
+		receiving.Add(1)
+		go func() {
+			defer receiving.Done()
+			for {
+				if err := source.Receive(sourceCtx, r.processMessageFunc(name, sourceType)); err != nil {
+					log.Errorf("failed to receive messages: %v", err)
+				}
+				select {
+				case <-sourceCtx.Done():
+					return
+				case <-time.After(messageSourceRetryDelay):
+				}
+			}
+		}()
+	}
+}
+
+// processMessageFunc returns the ProcessMessageFunc of the message source,
+// which processes its messages like the events received by the EventListener
+// on the /sources/<name> path.
+func (r Sink) processMessageFunc(name, sourceType string) ProcessMessageFunc {
+	log := r.Logger.With(zap.String("source", name))
+	return func(ctx context.Context, body []byte, header http.Header, wait bool) error {
+		err := r.processMessage(ctx, name, sourceType, body, header, wait)
+		if err != nil {
+			log.Errorf("failed to process a message: %v", err)
+		}
+		return err
+	}
+}
+
+func (r Sink) processMessage(ctx context.Context, name, sourceType string, body []byte, header http.Header, wait bool) error {
+	if wait {
+		ctx = withMessage(ctx)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/sources/"+name, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if header != nil {
+		request.Header = header.Clone()
+	}
+	if request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set(EventSourceHeader, sourceType)
+	if wait {
+		request.Header.Set(ResponseModeHeader, SyncResponseMode)
+	} else {
+		request.Header.Set(ResponseModeHeader, AsyncResponseMode)
+	}
+
+	response := &bufferedResponseWriter{discardResponseWriter: discardResponseWriter{header: http.Header{}}}
+	r.HandleEvent(response, request)
+	switch {
+	case response.status == http.StatusNotFound:
+		// Redelivering a message that matches no Trigger would not help
+		r.Logger.With(zap.String("source", name)).Warn("no Trigger matches the message")
+		return nil
+	case response.status >= http.StatusBadRequest:
+		return fmt.Errorf("the message was rejected with status %d", response.status)
+	case !wait:
+		return nil
+	}
+	var res Response
+	if err := json.Unmarshal(response.body.Bytes(), &res); err != nil {
+		return fmt.Errorf("failed to decode the response: %w", err)
+	}
+	if res.ErrorMessage != "" {
+		return errors.New(res.ErrorMessage)
+	}
+	for _, t := range res.Triggers {
+		if t.Error != "" {
+			return fmt.Errorf("failed to process Trigger %s: %s", t.Trigger, t.Error)
+		}
+	}
+	return nil
+}
+
+// bufferedResponseWriter records the status and body of the response to an
+// event generated by the EventListener.
+type bufferedResponseWriter struct {
+	discardResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	_, _ = w.discardResponseWriter.Write(b)
+	return w.body.Write(b)
+}
@@ -18,11 +18,14 @@ var (
 	elDuration         metric.Float64Histogram
 	eventRcdCount      metric.Int64Counter
 	triggeredResources metric.Int64Counter
+	queueDepth         metric.Int64UpDownCounter
+	eventRejectedCount metric.Int64Counter
 )
 
 const (
-	failTag    = "failed"
-	successTag = "succeeded"
+	failTag      = "failed"
+	successTag   = "succeeded"
+	duplicateTag = "duplicate"
 )
 
 var (
@@ -60,6 +63,22 @@ func initMetrics() error {
 		return fmt.Errorf("failed to create triggeredResources counter: %w", err)
 	}
 
+	queueDepth, err = meter.Int64UpDownCounter(
+		"eventlistener_queue_depth",
+		metric.WithDescription("number of triggers and trigger groups waiting to be processed"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create queueDepth counter: %w", err)
+	}
+
+	eventRejectedCount, err = meter.Int64Counter(
+		"eventlistener_event_rejected_total",
+		metric.WithDescription("number of events rejected by sink because its queue was full"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create eventRejectedCount counter: %w", err)
+	}
+
 	return nil
 }
 
@@ -110,6 +129,19 @@ func (s *Sink) recordCountMetrics(status string) {
 	eventRcdCount.Add(context.Background(), 1, metric.WithAttributes(attribute.String("status", status)))
 }
 
+func (s *Sink) recordRejection() {
+	s.Logger.Debugw("event listener request rejected")
+
+	eventRejectedCount.Add(context.Background(), 1)
+}
+
+// recordQueueDepth is called by WorkerPool, which can be used without metrics.
+func recordQueueDepth(delta int64) {
+	if queueDepth != nil {
+		queueDepth.Add(context.Background(), delta)
+	}
+}
+
 func (s *Sink) recordResourceCreation(resources []json.RawMessage) {
 	for _, rt := range resources {
 		// Assume the TriggerResourceTemplate is valid (it has an apiVersion and Kind)
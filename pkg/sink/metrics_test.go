@@ -20,6 +20,8 @@ func resetSinkMetrics() {
 	elDuration = nil
 	eventRcdCount = nil
 	triggeredResources = nil
+	queueDepth = nil
+	eventRejectedCount = nil
 }
 
 func setupTestProvider(t *testing.T) *sdkmetric.ManualReader {
@@ -71,6 +73,12 @@ func TestRecorderMetricsRegistered(t *testing.T) {
 	if eventRcdCount == nil {
 		t.Fatal("eventRcdCount metric not initialized")
 	}
+	if queueDepth == nil {
+		t.Fatal("queueDepth metric not initialized")
+	}
+	if eventRejectedCount == nil {
+		t.Fatal("eventRejectedCount metric not initialized")
+	}
 
 	_ = reader
 }
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NATSEventSource is the EventSourceHeader of the events of NATS sources.
+const NATSEventSource = "nats"
+
+const (
+	// defaultNATSRedeliveryDelay is how long JetStream waits before
+	// redelivering a message whose processing failed.
+	defaultNATSRedeliveryDelay = 10 * time.Second
+	// defaultNATSAckWait is how long JetStream waits for a message to be
+	// acknowledged or reported as in progress before redelivering it.
+	defaultNATSAckWait = 30 * time.Second
+	// defaultNATSMaxAckPending is the number of messages of a stream processed
+	// at the same time. JetStream does not deliver more messages until some
+	// of them are acknowledged.
+	defaultNATSMaxAckPending = 100
+)
+
+// natsSource receives the messages of a NATS subject, or of a JetStream
+// stream.
+type natsSource struct {
+	spec     triggersv1.NATSSource
+	consumer string
+	options  []nats.Option
+	// redeliveryDelay and ackWait configure the redelivery of the messages
+	// of a stream
+	redeliveryDelay time.Duration
+	ackWait         time.Duration
+	// maxAckPending is the number of messages of a stream processed at the
+	// same time
+	maxAckPending int
+}
+
+var _ MessageSource = (*natsSource)(nil)
+
+// newNATSSource returns the MessageSource of the NATS source, using the
+// credentials of its Secret.
+func (r Sink) newNATSSource(ctx context.Context, name string, s *triggersv1.NATSSource) (*natsSource, error) {
+	n := &natsSource{
+		spec:            *s,
+		consumer:        s.Consumer,
+		options:         []nats.Option{nats.Name(fmt.Sprintf("el-%s/%s", r.EventListenerName, name)), nats.MaxReconnects(-1)},
+		redeliveryDelay: defaultNATSRedeliveryDelay,
+		ackWait:         defaultNATSAckWait,
+		maxAckPending:   defaultNATSMaxAckPending,
+	}
+	if n.consumer == "" {
+		n.consumer = fmt.Sprintf("%s-%s", r.EventListenerName, name)
+	}
+	if s.SecretName == "" {
+		return n, nil
+	}
+	secret, err := r.KubeClientSet.CoreV1().Secrets(r.EventListenerNamespace).Get(ctx, s.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the credentials of the source: %w", err)
+	}
+	switch {
+	case len(secret.Data["token"]) > 0:
+		n.options = append(n.options, nats.Token(string(secret.Data["token"])))
+	case len(secret.Data[corev1.BasicAuthUsernameKey]) > 0:
+		n.options = append(n.options, nats.UserInfo(string(secret.Data[corev1.BasicAuthUsernameKey]), string(secret.Data[corev1.BasicAuthPasswordKey])))
+	default:
+		return nil, fmt.Errorf("secret %s has neither a token nor a username", s.SecretName)
+	}
+	return n, nil
+}
+
+// Receive receives the messages of the source until the context is done, and
+// returns once the messages being processed were processed. The messages of a
+// subject cannot be redelivered, so they are processed without waiting for
+// their Triggers. The messages of a stream are processed in their own
+// goroutine, up to maxAckPending at the same time, and acknowledged once their
+// Triggers were processed, or redelivered after the redeliveryDelay if their
+// processing failed. They are reported as in progress while they are
+// processed, so that JetStream does not redeliver them however long their
+// Triggers take.
+func (n *natsSource) Receive(ctx context.Context, process ProcessMessageFunc) error {
+	closed := make(chan struct{})
+	options := append(slices.Clip(n.options), nats.ClosedHandler(func(*nats.Conn) { close(closed) }))
+	nc, err := nats.Connect(n.spec.URL, options...)
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+
+	if n.spec.Stream == "" {
+		if _, err := nc.QueueSubscribe(n.spec.Subject, n.consumer, func(m *nats.Msg) {
+			// The error was logged, and the message cannot be redelivered
+			_ = process(ctx, m.Data, httpHeader(m.Header), false)
+		}); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		// Wait for the messages being processed, and for the connection to be
+		// closed
+		if err := nc.Drain(); err != nil {
+			return err
+		}
+		<-closed
+		return nil
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return err
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, n.spec.Stream, jetstream.ConsumerConfig{
+		Durable:       n.consumer,
+		FilterSubject: n.spec.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       n.ackWait,
+		MaxAckPending: n.maxAckPending,
+	})
+	if err != nil {
+		return err
+	}
+	var processing sync.WaitGroup
+	workers := make(chan struct{}, n.maxAckPending)
+	consuming, err := consumer.Consume(func(m jetstream.Msg) {
+		// Failing to acknowledge a message redelivers it after the AckWait of
+		// the consumer
+		stop := inProgress(m, n.ackWait)
+		processing.Add(1)
+		workers <- struct{}{}
+		go func() {
+			defer processing.Done()
+			defer func() { <-workers }()
+			err := process(ctx, m.Data(), httpHeader(m.Headers()), true)
+			stop()
+			if err != nil {
+				_ = m.NakWithDelay(n.redeliveryDelay)
+				return
+			}
+			_ = m.Ack()
+		}()
+	})
+	if err != nil {
+		return err
+	}
+	<-ctx.Done()
+	consuming.Drain()
+	<-consuming.Closed()
+	processing.Wait()
+	return nil
+}
+
+// inProgress reports the message as in progress three times per ackWait,
+// until the returned function is called.
+func inProgress(m jetstream.Msg, ackWait time.Duration) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(ackWait / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = m.InProgress()
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// httpHeader returns the headers of a NATS message as HTTP headers.
+func httpHeader(h nats.Header) http.Header {
+	header := http.Header{}
+	for k, values := range h {
+		for _, v := range values {
+			header.Add(k, v)
+		}
+	}
+	return header
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nats-io/nats-server/v2/server"
+	natstest "github.com/nats-io/nats-server/v2/test"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+// runNATSServer runs an in-process NATS server with JetStream enabled, which
+// requires the token if it is not empty.
+func runNATSServer(t *testing.T, token string) *server.Server {
+	t.Helper()
+	opts := natstest.DefaultTestOptions
+	opts.Port = server.RANDOM_PORT
+	opts.JetStream = true
+	opts.StoreDir = t.TempDir()
+	opts.Authorization = token
+	s := natstest.RunServer(&opts)
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+// receiveNATSMessages receives the messages of the source until the test
+// ends, and then waits for their Triggers to be processed.
+func receiveNATSMessages(t *testing.T, sink Sink, n *natsSource) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = n.Receive(ctx, sink.processMessageFunc("builds", NATSEventSource))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		sink.WGProcessTriggers.Wait()
+	})
+}
+
+func natsEventListener(t *testing.T, source *triggersv1beta1.NATSSource) *triggersv1beta1.EventListener {
+	t.Helper()
+	return &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "build-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository)")},
+					{Name: "revision", Value: ptr.String("$(header.X-Revision)")},
+					{Name: "name", Value: ptr.String("$(body.name)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+				Match:    &triggersv1beta1.RequestMatch{Path: "/sources/builds"},
+			}},
+			Sources: []triggersv1beta1.EventSource{{Name: "builds", NATS: source}},
+		},
+	}
+}
+
+// withWebhookInterceptor adds a webhook interceptor to the Trigger of the
+// EventListener, which is served by the webhookInterceptor of the sink.
+func withWebhookInterceptor(el *triggersv1beta1.EventListener) *triggersv1beta1.EventListener {
+	el.Spec.Triggers[0].Interceptors = []*triggersv1beta1.EventInterceptor{{
+		Webhook: &triggersv1beta1.WebhookInterceptor{
+			ObjectRef: &corev1.ObjectReference{APIVersion: "v1", Kind: "Service", Name: "foo"},
+		},
+	}}
+	return el
+}
+
+// countingInterceptor returns a webhook interceptor which passes the events
+// through once release returns, and counts them in received and the events
+// it processes at the same time in maxProcessing.
+func countingInterceptor(release func(), received, maxProcessing *atomic.Int32) http.Handler {
+	var processing atomic.Int32
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		n := processing.Add(1)
+		defer processing.Add(-1)
+		for m := maxProcessing.Load(); n > m && !maxProcessing.CompareAndSwap(m, n); m = maxProcessing.Load() {
+		}
+		release()
+		for k, v := range r.Header {
+			w.Header()[k] = v
+		}
+		_, _ = io.Copy(w, r.Body)
+	})
+}
+
+func TestNewNATSSource(t *testing.T) {
+	sink, _ := getSinkAssets(t, test.Resources{Secrets: []*corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty", Namespace: namespace},
+	}}}, "my-el", nil)
+
+	n, err := sink.newNATSSource(context.Background(), "builds", &triggersv1beta1.NATSSource{URL: "nats://nats:4222", Subject: "builds"})
+	if err != nil {
+		t.Fatalf("newNATSSource() = %v", err)
+	}
+	if n.consumer != "my-el-builds" {
+		t.Errorf("got consumer %q, want the default consumer", n.consumer)
+	}
+	if _, err := sink.newNATSSource(context.Background(), "builds", &triggersv1beta1.NATSSource{URL: "nats://nats:4222", Subject: "builds", SecretName: "empty"}); err == nil {
+		t.Error("newNATSSource() should fail for a Secret without credentials")
+	}
+}
+
+func TestNATSSource_Stream(t *testing.T) {
+	s := runNATSServer(t, "secret-token")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nc, err := nats.Connect(s.ClientURL(), nats.Token("secret-token"))
+	if err != nil {
+		t.Fatalf("error connecting to the NATS server: %v", err)
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatalf("error creating the JetStream client: %v", err)
+	}
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "BUILDS", Subjects: []string{"builds.>"}})
+	if err != nil {
+		t.Fatalf("error creating the stream: %v", err)
+	}
+	msg := nats.NewMsg("builds.main")
+	msg.Header.Set("X-Revision", "main")
+	msg.Data = []byte(`{"name": "build-1", "repository": "https://github.com/tektoncd/triggers"}`)
+	if _, err := js.PublishMsg(ctx, msg); err != nil {
+		t.Fatalf("error publishing the message: %v", err)
+	}
+
+	el := natsEventListener(t, &triggersv1beta1.NATSSource{
+		URL:        s.ClientURL(),
+		Subject:    "builds.>",
+		Stream:     "BUILDS",
+		SecretName: "nats-auth",
+	})
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners: []*triggersv1beta1.EventListener{el},
+		Secrets: []*corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{Name: "nats-auth", Namespace: namespace},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		}},
+	}, el.Name, nil)
+	sink.SyncResponseTimeout = 5 * time.Second
+
+	// The resources of the first delivery cannot be created, so the message
+	// must be redelivered
+	var creates atomic.Int32
+	dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+		if creates.Add(1) == 1 {
+			return true, nil, kerrors.NewServiceUnavailable("unavailable")
+		}
+		return false, nil, nil
+	})
+	n, err := sink.newNATSSource(ctx, "builds", el.Spec.Sources[0].NATS)
+	if err != nil {
+		t.Fatalf("newNATSSource() = %v", err)
+	}
+	n.redeliveryDelay = 10 * time.Millisecond
+	receiveNATSMessages(t, sink, n)
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	var tr *unstructured.Unstructured
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		var err error
+		tr, err = dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, "build-1", metav1.GetOptions{})
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("the TaskRun of the message was not created: %v", err)
+	}
+	params, _, _ := unstructured.NestedSlice(tr.Object, "spec", "params")
+	wantParams := []interface{}{
+		map[string]interface{}{"name": "url", "value": "https://github.com/tektoncd/triggers"},
+		map[string]interface{}{"name": "git-revision", "value": "main"},
+	}
+	if diff := cmp.Diff(wantParams, params); diff != "" {
+		t.Errorf("params of the TaskRun (-want, +got): %s", diff)
+	}
+
+	// The message is acknowledged once processed
+	consumer, err := stream.Consumer(ctx, "my-el-builds")
+	if err != nil {
+		t.Fatalf("the durable consumer was not created: %v", err)
+	}
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		info, err := consumer.Info(ctx)
+		if err != nil {
+			return false, err
+		}
+		return info.AckFloor.Stream == 1 && info.NumAckPending == 0, nil
+	}); err != nil {
+		t.Errorf("the message was not acknowledged: %v", err)
+	}
+	if got := creates.Load(); got != 2 {
+		t.Errorf("got %d creates, want the message to be delivered twice", got)
+	}
+}
+
+func TestNATSSource_SlowTriggers(t *testing.T) {
+	s := runNATSServer(t, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("error connecting to the NATS server: %v", err)
+	}
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatalf("error creating the JetStream client: %v", err)
+	}
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "BUILDS", Subjects: []string{"builds.>"}})
+	if err != nil {
+		t.Fatalf("error creating the stream: %v", err)
+	}
+	for _, name := range []string{"build-3", "build-4"} {
+		msg := nats.NewMsg("builds.main")
+		msg.Header.Set("X-Revision", "main")
+		msg.Data = []byte(`{"name": "` + name + `", "repository": "https://github.com/tektoncd/triggers"}`)
+		if _, err := js.PublishMsg(ctx, msg); err != nil {
+			t.Fatalf("error publishing the message: %v", err)
+		}
+	}
+
+	// The trigger takes longer than both the sync timeout and the AckWait, the
+	// messages must not be redelivered while they are processed, and must be
+	// processed at the same time
+	var received, maxProcessing atomic.Int32
+	el := withWebhookInterceptor(natsEventListener(t, &triggersv1beta1.NATSSource{URL: s.ClientURL(), Subject: "builds.>", Stream: "BUILDS"}))
+	sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name,
+		countingInterceptor(func() { time.Sleep(500 * time.Millisecond) }, &received, &maxProcessing))
+	sink.SyncResponseTimeout = 10 * time.Millisecond
+
+	n, err := sink.newNATSSource(ctx, "builds", el.Spec.Sources[0].NATS)
+	if err != nil {
+		t.Fatalf("newNATSSource() = %v", err)
+	}
+	n.redeliveryDelay = 10 * time.Millisecond
+	n.ackWait = 150 * time.Millisecond
+	receiveNATSMessages(t, sink, n)
+
+	consumer := func() jetstream.Consumer {
+		var c jetstream.Consumer
+		if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+			var err error
+			c, err = stream.Consumer(ctx, "my-el-builds")
+			return err == nil, nil
+		}); err != nil {
+			t.Fatalf("the durable consumer was not created: %v", err)
+		}
+		return c
+	}()
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		info, err := consumer.Info(ctx)
+		if err != nil {
+			return false, err
+		}
+		return info.AckFloor.Stream == 2 && info.NumAckPending == 0, nil
+	}); err != nil {
+		t.Fatalf("the messages were not acknowledged: %v", err)
+	}
+	info, err := consumer.Info(ctx)
+	if err != nil {
+		t.Fatalf("error getting the consumer info: %v", err)
+	}
+	if info.NumRedelivered != 0 {
+		t.Errorf("got %d redelivered messages, want none", info.NumRedelivered)
+	}
+	if got := received.Load(); got != 2 {
+		t.Errorf("got %d events, want each message to be processed once", got)
+	}
+	if got := maxProcessing.Load(); got != 2 {
+		t.Errorf("got %d messages processed at the same time, want 2", got)
+	}
+}
+
+func TestNATSSource_Subject(t *testing.T) {
+	s := runNATSServer(t, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The messages of a subject are not redelivered, the next message must
+	// not wait for the Triggers of the previous one
+	var received, maxProcessing atomic.Int32
+	released := make(chan struct{})
+	release := sync.OnceFunc(func() { close(released) })
+	el := withWebhookInterceptor(natsEventListener(t, &triggersv1beta1.NATSSource{URL: s.ClientURL(), Subject: "builds.*"}))
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name,
+		countingInterceptor(func() { <-released }, &received, &maxProcessing))
+	sink.SyncResponseTimeout = 5 * time.Second
+	subscriptions := s.NumSubscriptions()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sink.RunMessageSources(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-done
+		sink.WGProcessTriggers.Wait()
+	}()
+	defer release()
+
+	// Messages published before the subscription are not received
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+		return s.NumSubscriptions() > subscriptions, nil
+	}); err != nil {
+		t.Fatalf("the subject was not subscribed: %v", err)
+	}
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		t.Fatalf("error connecting to the NATS server: %v", err)
+	}
+	defer nc.Close()
+	for _, name := range []string{"build-2", "build-5"} {
+		msg := nats.NewMsg("builds.main")
+		msg.Header.Set("X-Revision", "main")
+		msg.Data = []byte(`{"name": "` + name + `", "repository": "https://github.com/tektoncd/triggers"}`)
+		if err := nc.PublishMsg(msg); err != nil {
+			t.Fatalf("error publishing the message: %v", err)
+		}
+	}
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 2*time.Second, true, func(context.Context) (bool, error) {
+		return received.Load() == 2, nil
+	}); err != nil {
+		t.Fatalf("got %d events, want both messages to be processed at the same time: %v", received.Load(), err)
+	}
+	release()
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		_, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(ctx, "build-2", metav1.GetOptions{})
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("the TaskRun of the message was not created: %v", err)
+	}
+}
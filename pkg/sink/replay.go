@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaderParts are the parts of the names of the headers that usually
+// hold credentials or signatures. These headers are always redacted.
+var sensitiveHeaderParts = []string{"authorization", "cookie", "token", "secret", "signature", "password", "api-key", "apikey"}
+
+// RecordedEvent is an event received by the EventListener and kept by the
+// EventStore, with its sensitive headers redacted.
+type RecordedEvent struct {
+	EventID    string          `json:"eventID"`
+	ReceivedAt time.Time       `json:"receivedAt"`
+	Method     string          `json:"method"`
+	URL        string          `json:"url"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	// StatusCode is the status code of the response to the event.
+	StatusCode int `json:"statusCode,omitempty"`
+	// Triggers are the results of the Triggers processed for the event.
+	Triggers []TriggerResult `json:"triggers,omitempty"`
+	// Replays is the number of times the event was replayed.
+	Replays int `json:"replays,omitempty"`
+}
+
+type storedEvent struct {
+	RecordedEvent
+	// header and body are the ones received, used to replay the event
+	header http.Header
+	body   []byte
+}
+
+// EventStore keeps the most recent events received by the EventListener so
+// that they can be listed and replayed. A nil EventStore does not keep anything.
+type EventStore struct {
+	maxEvents     int
+	redactHeaders map[string]bool
+
+	mu sync.Mutex
+	// events are sorted from the oldest to the most recent
+	events []*storedEvent
+}
+
+// NewEventStore returns an EventStore that keeps the maxEvents most recent
+// events, and redacts redactHeaders in addition to the sensitive headers.
+// It returns nil if maxEvents is not positive.
+func NewEventStore(maxEvents int, redactHeaders []string) *EventStore {
+	if maxEvents <= 0 {
+		return nil
+	}
+	s := &EventStore{
+		maxEvents:     maxEvents,
+		redactHeaders: map[string]bool{},
+	}
+	for _, h := range redactHeaders {
+		s.redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	return s
+}
+
+// record keeps the event, with the header and body received before DecodeBody
+// decompressed them. A replayed event, which reuses the ID of the original
+// event, resets the result of the original event instead.
+func (s *EventStore) record(eventID string, request *http.Request, body []byte) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e := s.find(eventID); e != nil {
+		e.Replays++
+		e.StatusCode = 0
+		e.Triggers = nil
+		return
+	}
+	header, raw := receivedRequest(request, body)
+	e := &storedEvent{
+		RecordedEvent: RecordedEvent{
+			EventID:    eventID,
+			ReceivedAt: time.Now(),
+			Method:     request.Method,
+			URL:        request.URL.RequestURI(),
+			Header:     s.redact(header),
+			Body:       bodyToJSON(body),
+		},
+		header: header,
+		body:   raw,
+	}
+	s.events = append(s.events, e)
+	if len(s.events) > s.maxEvents {
+		s.events[0] = nil
+		s.events = s.events[1:]
+	}
+}
+
+func (s *EventStore) setStatusCode(eventID string, statusCode int) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e := s.find(eventID); e != nil {
+		e.StatusCode = statusCode
+	}
+}
+
+func (s *EventStore) setTriggers(eventID string, results []TriggerResult) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e := s.find(eventID); e != nil {
+		e.Triggers = results
+	}
+}
+
+// get returns a copy of the event with the given ID.
+func (s *EventStore) get(eventID string) (storedEvent, bool) {
+	if s == nil {
+		return storedEvent{}, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e := s.find(eventID); e != nil {
+		return *e, true
+	}
+	return storedEvent{}, false
+}
+
+// list returns the events kept, from the most recent to the oldest.
+func (s *EventStore) list() []RecordedEvent {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]RecordedEvent, 0, len(s.events))
+	for i := len(s.events) - 1; i >= 0; i-- {
+		out = append(out, s.events[i].RecordedEvent)
+	}
+	return out
+}
+
+func (s *EventStore) find(eventID string) *storedEvent {
+	for _, e := range s.events {
+		if e.EventID == eventID {
+			return e
+		}
+	}
+	return nil
+}
+
+// redact returns a copy of the header with the sensitive headers, and the
+// headers to redact of the store, redacted. A nil EventStore only redacts the
+// sensitive headers.
+func (s *EventStore) redact(header http.Header) http.Header {
+	out := make(http.Header, len(header))
+	for k, v := range header {
+		if (s != nil && s.redactHeaders[http.CanonicalHeaderKey(k)]) || isSensitiveHeader(k) {
+			out[k] = []string{redacted}
+			continue
+		}
+		out[k] = append([]string(nil), v...)
+	}
+	return out
+}
+
+func isSensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	for _, part := range sensitiveHeaderParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// bodyToJSON returns the body as is if it is JSON, or as a JSON string otherwise.
+func bodyToJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return append(json.RawMessage(nil), body...)
+	}
+	s, _ := json.Marshal(string(body))
+	return s
+}
+
+type replayKey struct{}
+
+// replay identifies the event replayed by a request and, optionally, the only
+// Trigger to process.
+type replay struct {
+	eventID string
+	trigger string
+}
+
+func withReplay(ctx context.Context, eventID, trigger string) context.Context {
+	return context.WithValue(ctx, replayKey{}, replay{eventID: eventID, trigger: trigger})
+}
+
+func replayFromContext(ctx context.Context) (replay, bool) {
+	rp, ok := ctx.Value(replayKey{}).(replay)
+	return rp, ok
+}
+
+// AdminHandler returns the handler of the admin endpoint of the EventListener,
+// which lists the events kept by the EventStore and replays them. Requests must
+// have the AdminToken as bearer token.
+func (r Sink) AdminHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/events", r.listEvents)
+	mux.HandleFunc("GET /admin/events/{eventID}", r.getEvent)
+	mux.HandleFunc("POST /admin/events/{eventID}/replay", r.replayEvent)
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		if r.EventStore == nil {
+			http.NotFound(response, request)
+			return
+		}
+		if !authorized(response, request, r.AdminToken) {
+			return
+		}
+		mux.ServeHTTP(response, request)
+	})
+}
+
+// authorized checks that the request has the token as bearer token, and
+// responds with 401 otherwise. Requests are never authorized with an empty token.
+func authorized(response http.ResponseWriter, request *http.Request, token string) bool {
+	got, ok := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if token == "" || !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+		response.Header().Set("WWW-Authenticate", `Bearer realm="eventlistener"`)
+		http.Error(response, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+func (r Sink) listEvents(response http.ResponseWriter, _ *http.Request) {
+	r.writeJSON(response, struct {
+		Events []RecordedEvent `json:"events"`
+	}{Events: r.EventStore.list()})
+}
+
+func (r Sink) getEvent(response http.ResponseWriter, request *http.Request) {
+	e, ok := r.EventStore.get(request.PathValue("eventID"))
+	if !ok {
+		http.Error(response, fmt.Sprintf("event %s not found", request.PathValue("eventID")), http.StatusNotFound)
+		return
+	}
+	r.writeJSON(response, e.RecordedEvent)
+}
+
+// replayEvent processes a recorded event again, as it was received, through
+// DecodeBody, IsValidPayload and HandleEvent, with the same event ID. The
+// trigger query parameter limits the replay to the Trigger with that name.
+func (r Sink) replayEvent(response http.ResponseWriter, request *http.Request) {
+	eventID := request.PathValue("eventID")
+	e, ok := r.EventStore.get(eventID)
+	if !ok {
+		http.Error(response, fmt.Sprintf("event %s not found", eventID), http.StatusNotFound)
+		return
+	}
+	trigger := request.URL.Query().Get("trigger")
+	replayed, err := http.NewRequestWithContext(withReplay(request.Context(), eventID, trigger), e.Method, e.URL, bytes.NewReader(e.body))
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	replayed.Header = e.header.Clone()
+	if mode := requestedResponseMode(request); mode != "" {
+		replayed.Header.Set(ResponseModeHeader, mode)
+	}
+	r.Logger.Infof("replaying event %s", eventID)
+	r.DecodeBody(r.IsValidPayload(http.HandlerFunc(r.HandleEvent))).ServeHTTP(response, replayed)
+}
+
+func (r Sink) writeJSON(response http.ResponseWriter, body interface{}) {
+	response.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(response).Encode(body); err != nil {
+		r.Logger.Errorf("failed to write back response: %v", err)
+	}
+}
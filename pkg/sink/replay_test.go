@@ -0,0 +1,307 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
+	"github.com/tektoncd/triggers/test"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestEventStore(t *testing.T) {
+	s := NewEventStore(2, []string{"x-custom"})
+	for _, id := range []string{"1", "2", "3"} {
+		req := httptest.NewRequest(http.MethodPost, "/github?x=y", nil)
+		req.Header.Set("Authorization", "Bearer token")
+		req.Header.Set("X-Hub-Signature-256", "sha256=abc")
+		req.Header.Set("X-Custom", "custom")
+		req.Header.Set("X-Github-Event", "push")
+		s.record(id, req, []byte(`{"id": "`+id+`"}`))
+	}
+	s.setStatusCode("3", http.StatusAccepted)
+
+	var ids []string
+	for _, e := range s.list() {
+		ids = append(ids, e.EventID)
+	}
+	if diff := cmp.Diff([]string{"3", "2"}, ids); diff != "" {
+		t.Errorf("listed events (-want, +got): %s", diff)
+	}
+
+	e, ok := s.get("3")
+	if !ok {
+		t.Fatal("event 3 not found")
+	}
+	wantHeader := http.Header{
+		"Authorization":       {redacted},
+		"X-Hub-Signature-256": {redacted},
+		"X-Custom":            {redacted},
+		"X-Github-Event":      {"push"},
+	}
+	if diff := cmp.Diff(wantHeader, e.Header); diff != "" {
+		t.Errorf("redacted header (-want, +got): %s", diff)
+	}
+	if got := e.header.Get("X-Hub-Signature-256"); got != "sha256=abc" {
+		t.Errorf("got replayed signature %q, want the original one", got)
+	}
+	if e.URL != "/github?x=y" || e.StatusCode != http.StatusAccepted || string(e.Body) != `{"id": "3"}` {
+		t.Errorf("unexpected recorded event %+v", e.RecordedEvent)
+	}
+
+	s.record("3", httptest.NewRequest(http.MethodPost, "/", nil), nil)
+	if e, _ := s.get("3"); e.Replays != 1 || e.StatusCode != 0 || e.URL != "/github?x=y" {
+		t.Errorf("got replayed event %+v, want the original event with one replay", e.RecordedEvent)
+	}
+	if _, ok := s.get("1"); ok {
+		t.Error("event 1 should have been dropped")
+	}
+}
+
+func TestAdminHandler_Replay(t *testing.T) {
+	eventBody := []byte(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	trigger := func(name string) triggersv1beta1.EventListenerTrigger {
+		return triggersv1beta1.EventListenerTrigger{
+			Name: name,
+			Bindings: []*triggersv1beta1.EventListenerBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+			},
+			Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, name+"-run")},
+		}
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers:      []triggersv1beta1.EventListenerTrigger{trigger("trigger-a"), trigger("trigger-b")},
+			Deduplication: &triggersv1beta1.Deduplication{Key: "$(header.X-Delivery)"},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.DeliveryStore = newInMemoryDeliveryStore()
+	sink.EventStore = NewEventStore(10, nil)
+	sink.AdminToken = "admin-token"
+	sink.SyncResponseTimeout = 5 * time.Second
+
+	// The resources of the event cannot be created the first time
+	broken := true
+	dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+		if broken {
+			return true, nil, kerrors.NewBadRequest("template bug")
+		}
+		return false, nil, nil
+	})
+
+	eventServer := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer eventServer.Close()
+	req, _ := http.NewRequest(http.MethodPost, eventServer.URL, bytes.NewReader(eventBody))
+	req.Header.Set("X-Delivery", "delivery-1")
+	req.Header.Set("X-Hub-Signature-256", "sha256=abc")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error sending event: %v", err)
+	}
+	resp.Body.Close()
+	sink.WGProcessTriggers.Wait()
+	broken = false
+
+	// Replays must reuse the ID of the original event rather than a new one
+	template.UUID = func() string { return "new-event-id" }
+	defer func() { template.UUID = func() string { return eventID } }()
+
+	adminServer := httptest.NewServer(sink.AdminHandler())
+	defer adminServer.Close()
+	admin := func(method, path, token string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(method, adminServer.URL+path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("error sending admin request: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	for _, token := range []string{"", "wrong-token"} {
+		if resp := admin(http.MethodGet, "/admin/events", token); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("got status %d with token %q, want 401", resp.StatusCode, token)
+		}
+	}
+
+	var recorded RecordedEvent
+	if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		var list struct {
+			Events []RecordedEvent `json:"events"`
+		}
+		resp := admin(http.MethodGet, "/admin/events", "admin-token")
+		if err := json.NewDecoder(resp.Body).Decode(&list); err != nil || len(list.Events) != 1 {
+			t.Fatalf("got events %+v (%v), want the event", list, err)
+		}
+		recorded = list.Events[0]
+		return len(recorded.Triggers) == 2, nil
+	}); err != nil {
+		t.Fatalf("the results of the triggers were not recorded: %+v", recorded)
+	}
+	if recorded.EventID != eventID || recorded.StatusCode != http.StatusAccepted || recorded.Header.Get("X-Hub-Signature-256") != redacted {
+		t.Errorf("unexpected recorded event %+v", recorded)
+	}
+
+	if resp := admin(http.MethodPost, "/admin/events/unknown/replay", "admin-token"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("got status %d replaying an unknown event, want 404", resp.StatusCode)
+	}
+
+	resp = admin(http.MethodPost, "/admin/events/"+eventID+"/replay?trigger=trigger-a&responseMode=sync", "admin-token")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d replaying the event, want 200", resp.StatusCode)
+	}
+	var body Response
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("error decoding replay response: %v", err)
+	}
+	if body.EventID != eventID || body.Duplicate || len(body.Triggers) != 1 || body.Triggers[0].Trigger != "trigger-a" {
+		t.Errorf("unexpected replay response %+v", body)
+	}
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	tr, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "trigger-a-run", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the TaskRun of the replayed trigger was not created: %v", err)
+	}
+	if got := tr.GetLabels()["triggers.tekton.dev/triggers-eventid"]; got != eventID {
+		t.Errorf("got eventID label %q, want %q", got, eventID)
+	}
+	if _, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "trigger-b-run", metav1.GetOptions{}); err == nil {
+		t.Error("the TaskRun of the other trigger should not have been created")
+	}
+
+	var replayed RecordedEvent
+	if err := json.NewDecoder(admin(http.MethodGet, "/admin/events/"+eventID, "admin-token").Body).Decode(&replayed); err != nil {
+		t.Fatalf("error decoding event: %v", err)
+	}
+	if replayed.Replays != 1 {
+		t.Errorf("got %d replays, want 1", replayed.Replays)
+	}
+}
+
+// TestAdminHandler_ReplayCompressed checks that compressed events are replayed
+// as they were received, so that interceptors get their raw body again.
+func TestAdminHandler_ReplayCompressed(t *testing.T) {
+	eventBody := []byte(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	compressed := compress(t, "gzip", eventBody)
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.EventStore = NewEventStore(10, nil)
+	sink.AdminToken = "admin-token"
+	sink.SyncResponseTimeout = 5 * time.Second
+
+	eventServer := httptest.NewServer(sink.DecodeBody(sink.IsValidPayload(http.HandlerFunc(sink.HandleEvent))))
+	defer eventServer.Close()
+	req, _ := http.NewRequest(http.MethodPost, eventServer.URL, bytes.NewReader(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error sending event: %v", err)
+	}
+	resp.Body.Close()
+	sink.WGProcessTriggers.Wait()
+
+	e, ok := sink.EventStore.get(eventID)
+	if !ok {
+		t.Fatal("the event was not recorded")
+	}
+	if !bytes.Equal(e.body, compressed) || e.header.Get("Content-Encoding") != "gzip" {
+		t.Errorf("got body %q with Content-Encoding %q, want the compressed body", e.body, e.header.Get("Content-Encoding"))
+	}
+	if diff := cmp.Diff(string(eventBody), string(e.Body)); diff != "" {
+		t.Errorf("listed body (-want, +got): %s", diff)
+	}
+
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	if err := dynamicClient.Resource(gvr).Namespace(namespace).Delete(context.Background(), "git-clone-run", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("error deleting the TaskRun of the event: %v", err)
+	}
+
+	adminServer := httptest.NewServer(sink.AdminHandler())
+	defer adminServer.Close()
+	req, _ = http.NewRequest(http.MethodPost, adminServer.URL+"/admin/events/"+eventID+"/replay?responseMode=sync", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error replaying the event: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d replaying the event, want 200", resp.StatusCode)
+	}
+	tr, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "git-clone-run", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the TaskRun of the replayed event was not created: %v", err)
+	}
+	if data, _ := tr.MarshalJSON(); !bytes.Contains(data, []byte("testrevision")) {
+		t.Errorf("got TaskRun %s, want the params of the decompressed event", data)
+	}
+}
+
+func TestAdminHandler_Disabled(t *testing.T) {
+	sink := Sink{AdminToken: "admin-token"}
+	req := httptest.NewRequest(http.MethodGet, "/admin/events", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+	rec := httptest.NewRecorder()
+	sink.AdminHandler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("got status %d, want 404", rec.Code)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ResponseModeHeader is the request header used to select the response mode of the sink.
+	ResponseModeHeader = "Tekton-Triggers-Response-Mode"
+	// responseModeQueryParam is the query parameter used to select the response mode of the sink.
+	responseModeQueryParam = "responseMode"
+	// SyncResponseMode makes the sink wait for the triggers to be processed before responding.
+	SyncResponseMode = "sync"
+	// AsyncResponseMode makes the sink respond once the triggers are selected, even if
+	// the EventListener responds synchronously by default.
+	AsyncResponseMode = "async"
+)
+
+// TriggerResult describes the outcome of processing a Trigger or a TriggerGroup for an event.
+type TriggerResult struct {
+	// Trigger is the name of the Trigger. It is empty if the result is for a TriggerGroup
+	// whose interceptors stopped processing before any Trigger was selected.
+	Trigger string `json:"trigger,omitempty"`
+	// Namespace is the namespace of the Trigger.
+	Namespace string `json:"namespace,omitempty"`
+	// TriggerGroup is the name of the TriggerGroup that selected the Trigger, if any.
+	TriggerGroup string `json:"triggerGroup,omitempty"`
+	// Stopped is true if an interceptor stopped processing the event.
+	Stopped bool `json:"stopped,omitempty"`
+	// Status is the status returned by the interceptor that stopped processing the event.
+	Status *triggersv1.Status `json:"status,omitempty"`
+	// Skipped is true if the resources were not created because resources
+	// of the concurrency group of the Trigger were still running.
+	Skipped bool `json:"skipped,omitempty"`
+	// Superseded is true if the resources were not created because a newer
+	// event with the same debounce key was received.
+	Superseded bool `json:"superseded,omitempty"`
+	// Resources are the resources created for the Trigger.
+	Resources []ResourceReference `json:"resources,omitempty"`
+	// Error is the error that occurred while processing the Trigger.
+	Error string `json:"error,omitempty"`
+}
+
+// ResourceReference identifies a resource created by a Trigger.
+type ResourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func newResourceReference(obj *unstructured.Unstructured) ResourceReference {
+	return ResourceReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// eventResults collects the TriggerResults of a single event while its
+// triggers are processed concurrently.
+type eventResults struct {
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	results []TriggerResult
+}
+
+func (e *eventResults) add(res TriggerResult) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.results = append(e.results, res)
+}
+
+// list returns a copy of the results collected so far, sorted by TriggerGroup,
+// namespace and Trigger name.
+func (e *eventResults) list() []TriggerResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	out := make([]TriggerResult, len(e.results))
+	copy(out, e.results)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].TriggerGroup != out[j].TriggerGroup {
+			return out[i].TriggerGroup < out[j].TriggerGroup
+		}
+		if out[i].Namespace != out[j].Namespace {
+			return out[i].Namespace < out[j].Namespace
+		}
+		return out[i].Trigger < out[j].Trigger
+	})
+	return out
+}
+
+// failed returns true if the processing of a Trigger or TriggerGroup failed.
+func (e *eventResults) failed() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, res := range e.results {
+		if res.Error != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// wait waits for all triggers of the event to be processed, up to the timeout.
+// It returns false if the timeout expired first.
+func (e *eventResults) wait(timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return e.waitContext(ctx)
+}
+
+// waitContext waits for all triggers of the event to be processed, until the
+// context is done. It returns false if the context was done first.
+func (e *eventResults) waitContext(ctx context.Context) bool {
+	done := make(chan struct{})
+	go func() {
+		e.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// requestedResponseMode returns the response mode selected by the request,
+// from its header or else its query parameter, or "" if it selects none.
+func requestedResponseMode(request *http.Request) string {
+	mode := request.Header.Get(ResponseModeHeader)
+	if mode == "" {
+		mode = request.URL.Query().Get(responseModeQueryParam)
+	}
+	switch {
+	case strings.EqualFold(mode, SyncResponseMode):
+		return SyncResponseMode
+	case strings.EqualFold(mode, AsyncResponseMode):
+		return AsyncResponseMode
+	default:
+		return ""
+	}
+}
+
+// syncResponse returns true if the sink waits for the triggers of the request
+// to be processed before responding, and how long it waits. The response mode
+// selected by the request takes precedence over the one of the EventListener.
+func (r Sink) syncResponse(el *triggersv1.EventListener, request *http.Request) (bool, time.Duration) {
+	var response *triggersv1.Response
+	if el != nil {
+		response = el.Spec.Response
+	}
+	timeout := r.SyncResponseTimeout
+	if response != nil && response.Timeout != nil {
+		timeout = response.Timeout.Duration
+	}
+	switch requestedResponseMode(request) {
+	case SyncResponseMode:
+		return true, timeout
+	case AsyncResponseMode:
+		return false, timeout
+	default:
+		return response != nil && response.Mode == triggersv1.ResponseModeSync, timeout
+	}
+}
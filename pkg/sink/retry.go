@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/reconciler/events"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// retryPolicy returns the retry policy of the Trigger, or the one of the
+// EventListener if the Trigger does not have one.
+func retryPolicy(t triggersv1.Trigger, el *triggersv1.EventListener) *triggersv1.RetryPolicy {
+	if t.Spec.RetryPolicy != nil {
+		return t.Spec.RetryPolicy
+	}
+	return el.Spec.RetryPolicy
+}
+
+// withRetries calls create until it succeeds, fails with an error that is not
+// retryable, or the attempts of the policy are exhausted.
+func withRetries(policy *triggersv1.RetryPolicy, create func() error) error {
+	if policy == nil {
+		return create()
+	}
+	backoff := wait.Backoff{
+		Duration: policy.GetBackoff(),
+		Factor:   2,
+		Steps:    int(policy.GetMaxAttempts()),
+	}
+	conditions := policy.GetRetryOn()
+	return retry.OnError(backoff, func(err error) bool {
+		return isRetryable(err, conditions)
+	}, create)
+}
+
+func isRetryable(err error, conditions []triggersv1.RetryCondition) bool {
+	for _, c := range conditions {
+		switch c {
+		case triggersv1.RetryOnUnavailable:
+			if kerrors.IsServiceUnavailable(err) || kerrors.IsTooManyRequests(err) ||
+				kerrors.IsInternalError(err) || kerrors.IsUnexpectedServerError(err) ||
+				utilnet.IsConnectionRefused(err) || utilnet.IsConnectionReset(err) {
+				return true
+			}
+		case triggersv1.RetryOnTimeout:
+			if kerrors.IsServerTimeout(err) || kerrors.IsTimeout(err) ||
+				errors.Is(err, context.DeadlineExceeded) || utilnet.IsTimeout(err) {
+				return true
+			}
+		case triggersv1.RetryOnConflict:
+			// A resource that already exists is not retried, since creating
+			// it again would fail the same way
+			if kerrors.IsConflict(err) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deadLetter is sent to the dead letter destination of a retry policy when
+// resources could not be created.
+type deadLetter struct {
+	EventListener string `json:"eventListener"`
+	Namespace     string `json:"namespace"`
+	Trigger       string `json:"trigger"`
+	EventID       string `json:"eventID"`
+	Error         string `json:"error"`
+	// Header and Body are the ones of the event the resources were rendered
+	// from, with the sensitive headers redacted
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// Resources are the rendered resources that were not created
+	Resources []json.RawMessage `json:"resources"`
+}
+
+// sendDeadLetter sends the resources that were not created to the dead letter
+// destination of the policy, if any.
+func (r Sink) sendDeadLetter(policy *triggersv1.RetryPolicy, t triggersv1.Trigger, el *triggersv1.EventListener, dl deadLetter) error {
+	if policy == nil || policy.DeadLetter == nil {
+		return nil
+	}
+	switch {
+	case policy.DeadLetter.URI != "":
+		return r.sendDeadLetterCloudEvent(policy.DeadLetter.URI, el, dl)
+	case policy.DeadLetter.ConfigMap != nil:
+		return r.createDeadLetterConfigMap(policy.DeadLetter.ConfigMap, t, dl)
+	}
+	return nil
+}
+
+func (r Sink) sendDeadLetterCloudEvent(uri string, el *triggersv1.EventListener, dl deadLetter) error {
+	event := cloudevents.NewEvent()
+	event.SetID(dl.EventID)
+	event.SetType(events.TriggerDeadLetterV1)
+	event.SetSource(fmt.Sprintf("/apis/%s/v1beta1/namespaces/%s/eventlisteners/%s", triggers.GroupName, el.Namespace, el.Name))
+	event.SetSubject(dl.Namespace + "." + dl.Trigger)
+	if err := event.SetData(cloudevents.ApplicationJSON, dl); err != nil {
+		return fmt.Errorf("failed to set dead letter data: %w", err)
+	}
+	ctx := cloudevents.ContextWithRetriesExponentialBackoff(context.Background(), 10*time.Millisecond, 10)
+	if result := r.CEClient.Send(cloudevents.ContextWithTarget(ctx, uri), event); !cloudevents.IsACK(result) {
+		return fmt.Errorf("failed to send dead letter to %s: %w", uri, result)
+	}
+	return nil
+}
+
+func (r Sink) createDeadLetterConfigMap(cfg *triggersv1.DeadLetterConfigMap, t triggersv1.Trigger, dl deadLetter) error {
+	header, err := json.Marshal(dl.Header)
+	if err != nil {
+		return err
+	}
+	resources, err := json.Marshal(dl.Resources)
+	if err != nil {
+		return err
+	}
+	generateName := cfg.GenerateName
+	if generateName == "" {
+		generateName = t.Name + "-dead-letter-"
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: generateName,
+			Namespace:    t.Namespace,
+			Labels: map[string]string{
+				triggers.GroupName + triggers.EventListenerLabelKey: dl.EventListener,
+				triggers.GroupName + triggers.EventIDLabelKey:       dl.EventID,
+				triggers.GroupName + triggers.TriggerLabelKey:       dl.Trigger,
+			},
+		},
+		Data: map[string]string{
+			"error":     dl.Error,
+			"header":    string(header),
+			"body":      string(dl.Body),
+			"resources": string(resources),
+		},
+	}
+	if _, err := r.KubeClientSet.CoreV1().ConfigMaps(t.Namespace).Create(context.Background(), cm, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to create dead letter ConfigMap: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cloudeventstest "github.com/cloudevents/sdk-go/v2/client/test"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/reconciler/events"
+	"github.com/tektoncd/triggers/test"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestIsRetryable(t *testing.T) {
+	gr := schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}
+	defaults := (&triggersv1beta1.RetryPolicy{}).GetRetryOn()
+	for _, tc := range []struct {
+		name       string
+		err        error
+		conditions []triggersv1beta1.RetryCondition
+		want       bool
+	}{{
+		name:       "service unavailable",
+		err:        kerrors.NewServiceUnavailable("apiserver is shutting down"),
+		conditions: defaults,
+		want:       true,
+	}, {
+		name:       "failing admission webhook",
+		err:        kerrors.NewInternalError(errors.New(`failed calling webhook "validation.webhook.pipeline.tekton.dev"`)),
+		conditions: defaults,
+		want:       true,
+	}, {
+		name:       "server timeout",
+		err:        kerrors.NewServerTimeout(gr, "create", 1),
+		conditions: defaults,
+		want:       true,
+	}, {
+		name:       "wrapped timeout",
+		err:        errors.Join(errors.New("couldn't create resource"), context.DeadlineExceeded),
+		conditions: defaults,
+		want:       true,
+	}, {
+		name:       "conflict is not retried by default",
+		err:        kerrors.NewConflict(gr, "run", errors.New("conflict")),
+		conditions: defaults,
+		want:       false,
+	}, {
+		name:       "conflict",
+		err:        kerrors.NewConflict(gr, "run", errors.New("conflict")),
+		conditions: []triggersv1beta1.RetryCondition{triggersv1beta1.RetryOnConflict},
+		want:       true,
+	}, {
+		name:       "already exists",
+		err:        kerrors.NewAlreadyExists(gr, "run"),
+		conditions: []triggersv1beta1.RetryCondition{triggersv1beta1.RetryOnConflict},
+		want:       false,
+	}, {
+		name:       "forbidden",
+		err:        kerrors.NewForbidden(gr, "run", errors.New("forbidden")),
+		conditions: []triggersv1beta1.RetryCondition{triggersv1beta1.RetryOnUnavailable, triggersv1beta1.RetryOnTimeout, triggersv1beta1.RetryOnConflict},
+		want:       false,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isRetryable(tc.err, tc.conditions); got != tc.want {
+				t.Errorf("isRetryable() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_RetryPolicy(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	gr := schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}
+	unavailable := kerrors.NewServiceUnavailable("apiserver is shutting down")
+	forbidden := kerrors.NewForbidden(gr, "git-clone-run", errors.New("denied"))
+
+	for _, tc := range []struct {
+		name         string
+		policy       *triggersv1beta1.RetryPolicy
+		errs         []error
+		wantAttempts int
+		wantCreated  bool
+		wantCM       bool
+		wantCE       bool
+	}{{
+		name:         "without retry policy",
+		errs:         []error{unavailable},
+		wantAttempts: 1,
+	}, {
+		name: "succeeds after retries",
+		policy: &triggersv1beta1.RetryPolicy{
+			Backoff: &metav1.Duration{Duration: time.Millisecond},
+		},
+		errs:         []error{unavailable, unavailable},
+		wantAttempts: 3,
+		wantCreated:  true,
+	}, {
+		name: "error is not retryable",
+		policy: &triggersv1beta1.RetryPolicy{
+			Backoff:    &metav1.Duration{Duration: time.Millisecond},
+			DeadLetter: &triggersv1beta1.DeadLetter{ConfigMap: &triggersv1beta1.DeadLetterConfigMap{}},
+		},
+		errs:         []error{forbidden},
+		wantAttempts: 1,
+		wantCM:       true,
+	}, {
+		name: "retries exhausted",
+		policy: &triggersv1beta1.RetryPolicy{
+			MaxAttempts: ptr.Int32(2),
+			Backoff:     &metav1.Duration{Duration: time.Millisecond},
+			DeadLetter:  &triggersv1beta1.DeadLetter{URI: "http://dead-letter.default.svc"},
+		},
+		errs:         []error{unavailable, unavailable, unavailable},
+		wantAttempts: 2,
+		wantCE:       true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-el",
+					Namespace: namespace,
+					UID:       types.UID(elUID),
+				},
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						Name: "git-clone-trigger",
+						Bindings: []*triggersv1beta1.EventListenerBinding{
+							{Name: "url", Value: ptr.String("$(body.repository.url)")},
+							{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+							{Name: "name", Value: ptr.String("git-clone-run")},
+						},
+						Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+					}},
+					RetryPolicy: tc.policy,
+				},
+			}
+			sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+			ceClient, ceEvents := cloudeventstest.NewMockSenderClient(t, 1)
+			sink.CEClient = ceClient
+
+			errs := tc.errs
+			dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+				if len(errs) == 0 {
+					return false, nil, nil
+				}
+				err := errs[0]
+				errs = errs[1:]
+				return true, nil, err
+			})
+
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+			req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader(eventBody))
+			if err != nil {
+				t.Fatalf("error creating request: %s", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer secret-token")
+			req.Header.Set("X-Hub-Signature-256", "sha256=signature")
+			req.Header.Set("X-GitHub-Event", "push")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			resp.Body.Close()
+			sink.WGProcessTriggers.Wait()
+
+			if got := len(toTaskRun(t, dynamicClient.Actions())); got != tc.wantAttempts {
+				t.Errorf("got %d attempts to create the TaskRun, want %d", got, tc.wantAttempts)
+			}
+			_, err = dynamicClient.Resource(gr.WithVersion("v1")).Namespace(namespace).Get(context.Background(), "git-clone-run", metav1.GetOptions{})
+			if created := err == nil; created != tc.wantCreated {
+				t.Errorf("TaskRun created = %t, want %t", created, tc.wantCreated)
+			}
+
+			cms, err := sink.KubeClientSet.CoreV1().ConfigMaps(namespace).List(context.Background(), metav1.ListOptions{
+				LabelSelector: triggers.GroupName + triggers.EventIDLabelKey + "=" + eventID,
+			})
+			if err != nil {
+				t.Fatalf("error listing ConfigMaps: %v", err)
+			}
+			if gotCM := len(cms.Items) == 1; gotCM != tc.wantCM {
+				t.Errorf("dead letter ConfigMap created = %t, want %t", gotCM, tc.wantCM)
+			}
+			if tc.wantCM {
+				cm := cms.Items[0]
+				if cm.GenerateName != "git-clone-trigger-dead-letter-" {
+					t.Errorf("GenerateName = %q, want %q", cm.GenerateName, "git-clone-trigger-dead-letter-")
+				}
+				var resources []json.RawMessage
+				if err := json.Unmarshal([]byte(cm.Data["resources"]), &resources); err != nil || len(resources) != 1 {
+					t.Errorf("got dead letter resources %s, want the TaskRun", cm.Data["resources"])
+				}
+				if cm.Data["body"] != string(eventBody) {
+					t.Errorf("got dead letter body %s, want %s", cm.Data["body"], eventBody)
+				}
+				var header http.Header
+				if err := json.Unmarshal([]byte(cm.Data["header"]), &header); err != nil {
+					t.Fatalf("error decoding the dead letter header: %v", err)
+				}
+				for name, want := range map[string]string{
+					"Authorization":       redacted,
+					"X-Hub-Signature-256": redacted,
+					"X-Github-Event":      "push",
+				} {
+					if got := header.Get(name); got != want {
+						t.Errorf("got dead letter header %s %q, want %q", name, got, want)
+					}
+				}
+			}
+
+			// The mock client delivers the events it sends asynchronously
+			timeout := 10 * time.Millisecond
+			if tc.wantCE {
+				timeout = 5 * time.Second
+			}
+			select {
+			case ev := <-ceEvents:
+				if !tc.wantCE {
+					t.Errorf("unexpected dead letter CloudEvent %v", ev)
+				} else if ev.Type() != events.TriggerDeadLetterV1 || ev.ID() != eventID {
+					t.Errorf("got CloudEvent with type %q and id %q", ev.Type(), ev.ID())
+				} else {
+					var dl deadLetter
+					if err := ev.DataAs(&dl); err != nil {
+						t.Fatalf("error decoding the dead letter: %v", err)
+					}
+					if got := dl.Header.Get("Authorization"); got != redacted {
+						t.Errorf("got dead letter header Authorization %q, want %q", got, redacted)
+					}
+				}
+			case <-time.After(timeout):
+				if tc.wantCE {
+					t.Error("dead letter CloudEvent was not sent")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// EventSourceHeader is the header identifying the source of the events
+	// generated by the EventListener itself.
+	EventSourceHeader = "Tekton-Event-Source"
+	// ScheduleEventSource is the EventSourceHeader of scheduled events.
+	ScheduleEventSource = "schedule"
+	// ScheduledTimeHeader is the header holding the time of scheduled events.
+	ScheduledTimeHeader = "Tekton-Scheduled-Time"
+)
+
+// scheduleTick is how often the EventListener checks whether schedules are due.
+var scheduleTick = 10 * time.Second
+
+type scheduledTriggerKey struct{}
+
+// withScheduledTrigger marks the request as a scheduled event of the Trigger.
+func withScheduledTrigger(ctx context.Context, trigger string) context.Context {
+	return context.WithValue(ctx, scheduledTriggerKey{}, trigger)
+}
+
+// scheduledTriggerFromContext returns the Trigger of a scheduled event, or
+// an empty string if the event was not scheduled.
+func scheduledTriggerFromContext(ctx context.Context) string {
+	trigger, _ := ctx.Value(scheduledTriggerKey{}).(string)
+	return trigger
+}
+
+// RunSchedules generates the events of the Triggers with a schedule until the
+// context is done. Each event is generated by a single replica of the
+// EventListener, which records it in a Lease.
+func (r Sink) RunSchedules(ctx context.Context) {
+	ticker := time.NewTicker(scheduleTick)
+	defer ticker.Stop()
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.fireSchedules(ctx, last, now)
+			last = now
+		}
+	}
+}
+
+// fireSchedules generates the events of the schedules that were due after
+// from and until to. A schedule due several times only generates the last one.
+func (r Sink) fireSchedules(ctx context.Context, from, to time.Time) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		r.Logger.Errorf("Error getting EventListener %s in Namespace %s: %s", r.EventListenerName, r.EventListenerNamespace, err)
+		return
+	}
+	trItems, err := r.selectTriggers(el.Spec.NamespaceSelector, el.Spec.LabelSelector)
+	if err != nil {
+		r.Logger.Errorf("unable to select configured mergedTriggers: %s", err)
+		return
+	}
+	mergedTriggers, err := r.merge(el.Spec.Triggers, trItems)
+	if err != nil {
+		r.Logger.Errorf("error merging triggers: %s", err)
+		return
+	}
+	for _, t := range mergedTriggers {
+		s := t.Spec.Schedule
+		if s == nil {
+			continue
+		}
+		log := r.Logger.With(zap.String(triggers.TriggerLabelKey, t.Name))
+		due, err := lastDue(s, from, to)
+		if err != nil {
+			log.Errorf("invalid schedule: %v", err)
+			continue
+		}
+		if due.IsZero() {
+			continue
+		}
+		claimed, err := r.claimSchedule(ctx, el, t, due)
+		if err != nil {
+			log.Errorf("failed to claim the event scheduled at %s: %v", due, err)
+			continue
+		}
+		if !claimed {
+			log.Debugf("the event scheduled at %s was generated by another replica", due)
+			continue
+		}
+		r.fireSchedule(ctx, t, due)
+	}
+}
+
+// lastDue returns the last time the schedule was due after from and until to,
+// in the time zone of the schedule, or the zero time if it was not due.
+func lastDue(s *triggersv1.Schedule, from, to time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+	loc, err := s.GetLocation()
+	if err != nil {
+		return time.Time{}, err
+	}
+	var due time.Time
+	for next := schedule.Next(from.In(loc)); !next.After(to); next = schedule.Next(next) {
+		due = next
+	}
+	return due, nil
+}
+
+// claimSchedule records the event of the Trigger scheduled at due in the Lease
+// of the schedule. It returns false if another replica already recorded it.
+func (r Sink) claimSchedule(ctx context.Context, el *triggersv1.EventListener, t *triggersv1.Trigger, due time.Time) (bool, error) {
+	leases := r.KubeClientSet.CoordinationV1().Leases(r.EventListenerNamespace)
+	sum := sha256.Sum256([]byte(r.EventListenerName + "/" + t.Namespace + "/" + t.Name))
+	name := "el-schedule-" + hex.EncodeToString(sum[:])[:40]
+	holder := due.UTC().Format(time.RFC3339)
+	now := metav1.NewMicroTime(time.Now())
+
+	lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		_, err := leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: r.EventListenerNamespace,
+				Labels: map[string]string{
+					triggers.GroupName + triggers.EventListenerLabelKey: r.EventListenerName,
+					triggers.GroupName + triggers.TriggerLabelKey:       t.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(el)},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity: ptr.To(holder),
+				AcquireTime:    &now,
+			},
+		}, metav1.CreateOptions{})
+		if kerrors.IsAlreadyExists(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	if err != nil {
+		return false, err
+	}
+	if ptr.Deref(lease.Spec.HolderIdentity, "") >= holder {
+		return false, nil
+	}
+	lease.Spec.HolderIdentity = ptr.To(holder)
+	lease.Spec.AcquireTime = &now
+	if _, err := leases.Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+		if kerrors.IsConflict(err) {
+			// Another replica recorded the event first
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// fireSchedule generates the event of the Trigger scheduled at due, and
+// processes it like the events received by the EventListener.
+func (r Sink) fireSchedule(ctx context.Context, t *triggersv1.Trigger, due time.Time) {
+	log := r.Logger.With(zap.String(triggers.TriggerLabelKey, t.Name))
+	scheduledTime := due.Format(time.RFC3339)
+	body := []byte("{}")
+	if t.Spec.Schedule.Body != nil {
+		body = bytes.ReplaceAll(t.Spec.Schedule.Body.Raw, []byte(triggersv1.ScheduleTimeVar), []byte(scheduledTime))
+	}
+	request, err := http.NewRequestWithContext(withScheduledTrigger(ctx, t.Name), http.MethodPost, "/", bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("failed to generate the event scheduled at %s: %v", scheduledTime, err)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventSourceHeader, ScheduleEventSource)
+	request.Header.Set(ScheduledTimeHeader, scheduledTime)
+	log.Infof("generating the event scheduled at %s", scheduledTime)
+
+	response := &discardResponseWriter{header: http.Header{}}
+	r.HandleEvent(response, request)
+	if response.status >= http.StatusBadRequest {
+		log.Errorf("the event scheduled at %s was rejected with status %d", scheduledTime, response.status)
+	}
+}
+
+// discardResponseWriter records the status of the response to an event
+// generated by the EventListener, and discards its body.
+type discardResponseWriter struct {
+	header http.Header
+	status int
+}
+
+func (w *discardResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *discardResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return len(b), nil
+}
+
+func (w *discardResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+func TestLastDue(t *testing.T) {
+	at := func(s string) time.Time {
+		t.Helper()
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	for _, tc := range []struct {
+		name     string
+		schedule triggersv1beta1.Schedule
+		from, to string
+		want     string
+	}{{
+		name:     "due",
+		schedule: triggersv1beta1.Schedule{Cron: "*/5 * * * *"},
+		from:     "2026-01-01T00:04:30Z",
+		to:       "2026-01-01T00:05:30Z",
+		want:     "2026-01-01T00:05:00Z",
+	}, {
+		name:     "not due",
+		schedule: triggersv1beta1.Schedule{Cron: "*/5 * * * *"},
+		from:     "2026-01-01T00:05:30Z",
+		to:       "2026-01-01T00:06:30Z",
+	}, {
+		name:     "due several times",
+		schedule: triggersv1beta1.Schedule{Cron: "*/5 * * * *"},
+		from:     "2026-01-01T00:00:00Z",
+		to:       "2026-01-01T00:11:00Z",
+		want:     "2026-01-01T00:10:00Z",
+	}, {
+		name:     "time zone",
+		schedule: triggersv1beta1.Schedule{Cron: "0 9 * * *", TimeZone: "Asia/Tokyo"},
+		from:     "2026-01-01T23:59:30Z",
+		to:       "2026-01-02T00:00:30Z",
+		want:     "2026-01-02T09:00:00+09:00",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := lastDue(&tc.schedule, at(tc.from), at(tc.to))
+			if err != nil {
+				t.Fatalf("lastDue() = %v", err)
+			}
+			switch {
+			case tc.want == "" && !got.IsZero():
+				t.Errorf("got due time %s, want none", got)
+			case tc.want != "" && got.Format(time.RFC3339) != tc.want:
+				t.Errorf("got due time %s, want %s", got.Format(time.RFC3339), tc.want)
+			}
+		})
+	}
+}
+
+func TestFireSchedules(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "nightly-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.time)")},
+					{Name: "revision", Value: ptr.String("$(header.Tekton-Event-Source)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "nightly-run")},
+				Schedule: &triggersv1beta1.Schedule{
+					Cron:     "0 9 * * *",
+					TimeZone: "Asia/Tokyo",
+					Body:     &apiextensionsv1.JSON{Raw: []byte(`{"time": "$(schedule.time)"}`)},
+				},
+			}, {
+				Name: "push-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "push-run")},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.SyncResponseTimeout = 5 * time.Second
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+
+	from := time.Date(2026, 1, 1, 23, 59, 30, 0, time.UTC)
+	to := from.Add(time.Minute)
+	sink.fireSchedules(context.Background(), from, to)
+	sink.WGProcessTriggers.Wait()
+
+	tr, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "nightly-run", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the TaskRun of the scheduled event was not created: %v", err)
+	}
+	params, _, _ := unstructured.NestedSlice(tr.Object, "spec", "params")
+	wantParams := []interface{}{
+		map[string]interface{}{"name": "url", "value": "2026-01-02T09:00:00+09:00"},
+		map[string]interface{}{"name": "git-revision", "value": ScheduleEventSource},
+	}
+	if b, want := mustMarshal(t, params), mustMarshal(t, wantParams); b != want {
+		t.Errorf("got params %s, want %s", b, want)
+	}
+	if _, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "push-run", metav1.GetOptions{}); err == nil {
+		t.Error("the scheduled event should only be processed by its Trigger")
+	}
+
+	// Another replica checking the same schedule does not generate the event again
+	creates := len(dynamicClient.Actions())
+	sink.fireSchedules(context.Background(), from, to)
+	sink.WGProcessTriggers.Wait()
+	if got := len(dynamicClient.Actions()); got != creates {
+		t.Errorf("got %d more actions, want the event to be generated once", got-creates)
+	}
+
+	// The events received by the EventListener are not processed by Triggers with a schedule
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+	req, _ := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader([]byte(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)))
+	req.Header.Set(ResponseModeHeader, SyncResponseMode)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("error sending request: %s", err)
+	}
+	defer resp.Body.Close()
+	var body Response
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("error decoding response: %v", err)
+	}
+	if len(body.Triggers) != 1 || body.Triggers[0].Trigger != "push-trigger" {
+		t.Errorf("got triggers %+v, want only push-trigger", body.Triggers)
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// errAbandoned fails the events held outside of the workers when the
+// EventListener shuts down.
+var errAbandoned = errors.New("abandoned as the EventListener is shutting down")
+
+// InFlightEvents keeps track of the events whose triggers or triggerGroups are
+// being processed, so that the events abandoned by a shutdown can be reported.
+type InFlightEvents struct {
+	mu sync.Mutex
+	// events maps the ID of each event to the number of its triggers and
+	// triggerGroups being processed
+	events map[string]int
+}
+
+// NewInFlightEvents returns an empty InFlightEvents.
+func NewInFlightEvents() *InFlightEvents {
+	return &InFlightEvents{events: map[string]int{}}
+}
+
+// add records that n more triggers or triggerGroups of the event are being processed.
+func (e *InFlightEvents) add(eventID string, n int) {
+	if e == nil || n == 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events[eventID] += n
+}
+
+// done records that a trigger or triggerGroup of the event was processed.
+func (e *InFlightEvents) done(eventID string) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.events[eventID] <= 1 {
+		delete(e.events, eventID)
+		return
+	}
+	e.events[eventID]--
+}
+
+// List returns the sorted IDs of the events being processed.
+func (e *InFlightEvents) List() []string {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ids := make([]string, 0, len(e.events))
+	for id := range e.events {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Drain waits for the triggers and triggerGroups being processed to complete,
+// until the context is done. The events held by their debounce, or waiting for
+// their turn in a concurrency group, may wait for long, so they are abandoned
+// right away. It returns the IDs of the abandoned events, and of the events
+// that are still being processed.
+func (r Sink) Drain(ctx context.Context) []string {
+	abandoned := append(r.Debouncer.abandon(), r.ConcurrencyGroups.abandon()...)
+	done := make(chan struct{})
+	go func() {
+		r.WGProcessTriggers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		abandoned = append(abandoned, r.InFlight.List()...)
+	}
+	sort.Strings(abandoned)
+	return slices.Compact(abandoned)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInFlightEvents(t *testing.T) {
+	e := NewInFlightEvents()
+	e.add("b", 2)
+	e.add("a", 1)
+	e.add("c", 0)
+	if diff := cmp.Diff([]string{"a", "b"}, e.List()); diff != "" {
+		t.Errorf("List() (-want,+got): %s", diff)
+	}
+	e.done("b")
+	e.done("a")
+	if diff := cmp.Diff([]string{"b"}, e.List()); diff != "" {
+		t.Errorf("List() (-want,+got): %s", diff)
+	}
+	e.done("b")
+	if got := e.List(); len(got) != 0 {
+		t.Errorf("List() = %v, want no events", got)
+	}
+
+	// A Sink without InFlightEvents does not keep track of events
+	var nilEvents *InFlightEvents
+	nilEvents.add("a", 1)
+	nilEvents.done("a")
+	if got := nilEvents.List(); got != nil {
+		t.Errorf("List() = %v, want nil", got)
+	}
+}
+
+func TestSink_Drain(t *testing.T) {
+	t.Run("events processed", func(t *testing.T) {
+		r := Sink{WGProcessTriggers: &sync.WaitGroup{}, InFlight: NewInFlightEvents()}
+		r.WGProcessTriggers.Add(1)
+		r.InFlight.add("event", 1)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			r.InFlight.done("event")
+			r.WGProcessTriggers.Done()
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if abandoned := r.Drain(ctx); len(abandoned) != 0 {
+			t.Errorf("Drain() = %v, want no abandoned events", abandoned)
+		}
+	})
+
+	t.Run("events abandoned", func(t *testing.T) {
+		r := Sink{WGProcessTriggers: &sync.WaitGroup{}, InFlight: NewInFlightEvents()}
+		r.WGProcessTriggers.Add(2)
+		r.InFlight.add("event", 2)
+		defer r.WGProcessTriggers.Add(-2)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if diff := cmp.Diff([]string{"event"}, r.Drain(ctx)); diff != "" {
+			t.Errorf("Drain() (-want,+got): %s", diff)
+		}
+	})
+
+	t.Run("queued events abandoned", func(t *testing.T) {
+		r := Sink{WGProcessTriggers: &sync.WaitGroup{}, InFlight: NewInFlightEvents(), ConcurrencyGroups: NewConcurrencyGroups()}
+		// Both events wait for the resources of their group
+		for _, id := range []string{"waiting", "queued"} {
+			r.WGProcessTriggers.Add(1)
+			r.InFlight.add(id, 1)
+			r.ConcurrencyGroups.run("group", &queuedEvent{
+				eventID: id,
+				timeout: time.Hour,
+				process: func() bool { return true },
+				drop: func(error) {
+					r.InFlight.done(id)
+					r.WGProcessTriggers.Done()
+				},
+			}, 2, func(func()) {})
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if diff := cmp.Diff([]string{"queued", "waiting"}, r.Drain(ctx)); diff != "" {
+			t.Errorf("Drain() (-want,+got): %s", diff)
+		}
+	})
+	t.Run("debounced events abandoned", func(t *testing.T) {
+		r := Sink{WGProcessTriggers: &sync.WaitGroup{}, InFlight: NewInFlightEvents(), Debouncer: NewDebouncer()}
+		for _, key := range []string{"main", "release"} {
+			id := key
+			r.WGProcessTriggers.Add(1)
+			r.InFlight.add(id, 1)
+			r.Debouncer.hold(key, time.Hour, &pendingEvent{
+				eventID: id,
+				process: func() { t.Errorf("event %s should not be processed", id) },
+				drop: func(error) {
+					r.InFlight.done(id)
+					r.WGProcessTriggers.Done()
+				},
+			}, func(f func()) { f() })
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if diff := cmp.Diff([]string{"main", "release"}, r.Drain(ctx)); diff != "" {
+			t.Errorf("Drain() (-want,+got): %s", diff)
+		}
+	})
+}
@@ -23,15 +23,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	net "net/url"
 	"os"
+	"strconv"
 	"sync"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
 	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
@@ -46,6 +50,7 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	discoveryclient "k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -71,10 +76,50 @@ type Sink struct {
 	Auth                   AuthOverride
 	PayloadValidation      bool
 	CloudEventURI          string
-	// WGProcessTriggers keeps track of triggers or triggerGroups currently being processed
-	// Currently only used in tests to wait for all triggers to finish processing
+	// MaxBodySize is the maximum size in bytes of the body of a request,
+	// before and after its decompression. Unlimited if 0.
+	MaxBodySize int64
+	// Authentication authenticates the requests received by the EventListener
+	// before they are processed. Requests are not authenticated if nil.
+	Authentication *Authentication
+	// CoreInterceptors runs the core interceptors in-process rather than
+	// calling the core interceptors service. They are called over HTTP if nil.
+	CoreInterceptors CoreInterceptors
+	// SyncResponseTimeout is how long the sink waits for the triggers of an event
+	// to be processed when a synchronous response is requested
+	SyncResponseTimeout time.Duration
+	// DeliveryStore records deliveries to skip repeated ones when the
+	// EventListener configures deduplication
+	DeliveryStore DeliveryStore
+	// Workers bounds the number of triggers and triggerGroups processed concurrently
+	Workers *WorkerPool
+	// RetryAfter is sent in the Retry-After header when an event is rejected
+	// because the Workers queue is full
+	RetryAfter time.Duration
+	// EventStore keeps the most recent events so that they can be replayed
+	// through the admin endpoint
+	EventStore *EventStore
+	// AdminToken is the bearer token of the admin endpoint
+	AdminToken string
+	// ConcurrencyGroups serializes the events of the same concurrency group
+	ConcurrencyGroups *ConcurrencyGroups
+	// Debouncer holds the events of Triggers that debounce them
+	Debouncer *Debouncer
+	// WGProcessTriggers keeps track of triggers or triggerGroups currently being processed,
+	// so that Drain can wait for them to finish processing on shutdown
 	WGProcessTriggers *sync.WaitGroup
-	EventRecorder     record.EventRecorder
+	// InFlight keeps track of the events whose triggers or triggerGroups are
+	// currently being processed
+	InFlight *InFlightEvents
+	// Decisions keeps the most recent decisions of each trigger so that they
+	// can be listed through the debug endpoints
+	Decisions *DecisionStore
+	// DebugToken is the bearer token of the debug endpoints
+	DebugToken string
+	// PodName is the name of the replica serving the debug endpoints, since
+	// each replica keeps its own Decisions
+	PodName       string
+	EventRecorder record.EventRecorder
 
 	// listers index properties about resources
 	EventListenerLister         listers.EventListenerLister
@@ -86,6 +131,15 @@ type Sink struct {
 	InterceptorLister           listersv1alpha1.InterceptorLister
 }
 
+// CoreInterceptors runs the core interceptors, which are otherwise served by
+// the core interceptors service, in the EventListener process.
+type CoreInterceptors interface {
+	// Handles returns true if a core interceptor is served at the path.
+	Handles(path string) bool
+	// Execute runs the core interceptor served at the path.
+	Execute(ctx context.Context, path string, req *triggersv1.InterceptorRequest) (*triggersv1.InterceptorResponse, error)
+}
+
 // Response defines the HTTP body that the Sink responds to events with.
 type Response struct {
 	// EventListener is the name of the eventListener.
@@ -100,6 +154,12 @@ type Response struct {
 	EventID string `json:"eventID,omitempty"`
 	// ErrorMessage gives message about Error which occurs during event processing
 	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Triggers lists the outcome of each Trigger evaluated for the event.
+	// It is only set when a synchronous response is requested.
+	Triggers []TriggerResult `json:"triggers,omitempty"`
+	// Duplicate is true if the event is a repeated delivery that was skipped.
+	// EventID is then the ID of the event first received for the delivery.
+	Duplicate bool `json:"duplicate,omitempty"`
 }
 
 func (r Sink) emitEvents(recorder record.EventRecorder, el *triggersv1.EventListener, eventType string, err error) {
@@ -110,13 +170,31 @@ func (r Sink) emitEvents(recorder record.EventRecorder, el *triggersv1.EventList
 
 // HandleEvent processes an incoming HTTP event for the event listener.
 func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
+	// Each CloudEvent of a batch is processed as an event
+	if isCloudEventBatch(request) {
+		r.handleCloudEventBatch(response, request)
+		return
+	}
+
 	log := r.Logger.With(
 		zap.String("eventlistener", r.EventListenerName),
 		zap.String("namespace", r.EventListenerNamespace),
 	)
 	eventID := template.UUID()
+	// Replays reuse the ID of the original event
+	if rp, ok := replayFromContext(request.Context()); ok {
+		eventID = rp.eventID
+	}
 	log = log.With(zap.String(triggers.EventIDLabelKey, eventID))
 
+	if r.EventStore != nil {
+		recorder := &StatusRecorder{ResponseWriter: response, Status: http.StatusOK}
+		response = recorder
+		defer func() {
+			r.EventStore.setStatusCode(eventID, recorder.Status)
+		}()
+	}
+
 	elTemp := triggersv1.EventListener{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "EventListener",
@@ -159,6 +237,18 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 		r.sendCloudEvents(request.Header, elTemp, eventID, events.TriggerProcessingFailedV1)
 		return
 	}
+	r.EventStore.record(eventID, request, event)
+
+	// The data of CloudEvents sent in structured mode is processed as the body
+	request, event, err = unwrapCloudEvent(request, event)
+	if err != nil {
+		log.Errorf("Error reading CloudEvent: %s", err)
+		r.recordCountMetrics(failTag)
+		response.WriteHeader(http.StatusBadRequest)
+		r.emitEvents(r.EventRecorder, &elTemp, events.TriggerProcessingFailedV1, err)
+		r.sendCloudEvents(nil, elTemp, eventID, events.TriggerProcessingFailedV1)
+		return
+	}
 
 	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
 	if err != nil {
@@ -193,24 +283,113 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
 		return
 	}
-	r.WGProcessTriggers.Add(len(mergedTriggers))
-	for _, t := range mergedTriggers {
-		go func(t triggersv1.Trigger) {
-			defer r.WGProcessTriggers.Done()
+
+	// Only process the triggers and trigger groups that match the request path and method
+	matchedTriggers := matchTriggers(mergedTriggers, request)
+	var matchedGroups []triggersv1.EventListenerTriggerGroup
+	for _, group := range el.Spec.TriggerGroups {
+		// Scheduled events are only processed by their Trigger
+		if scheduledTriggerFromContext(request.Context()) == "" && group.Match.Matches(request) {
+			matchedGroups = append(matchedGroups, group)
+		}
+	}
+	if len(mergedTriggers)+len(el.Spec.TriggerGroups) > 0 && len(matchedTriggers)+len(matchedGroups) == 0 {
+		err := fmt.Errorf("no triggers match %s request with path %s", request.Method, request.URL.Path)
+		log.Info(err)
+		r.recordCountMetrics(failTag)
+		response.WriteHeader(http.StatusNotFound)
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
+		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
+		return
+	}
+
+	tasks := len(matchedTriggers) + len(matchedGroups)
+	if !r.Workers.admit(tasks) {
+		err := errors.New("too many events are being processed")
+		log.Warn(err)
+		r.recordCountMetrics(failTag)
+		r.recordRejection()
+		response.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(r.RetryAfter.Seconds()))))
+		response.WriteHeader(http.StatusServiceUnavailable)
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
+		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
+		return
+	}
+
+	deliveryKey, originalEventID, duplicate := r.isDuplicate(el, request, event, eventID, log)
+	if duplicate {
+		r.Workers.cancel(tasks)
+		log.Infof("skipping repeated delivery of event %s", originalEventID)
+		r.recordCountMetrics(duplicateTag)
+		response.Header().Set("Content-Type", "application/json")
+		response.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(response).Encode(Response{
+			EventListener:    r.EventListenerName,
+			EventListenerUID: elUID,
+			Namespace:        r.EventListenerNamespace,
+			EventID:          originalEventID,
+			Duplicate:        true,
+		}); err != nil {
+			log.Errorf("failed to write back sink response: %v", err)
+		}
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingDoneV1, nil)
+		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingDoneV1)
+		return
+	}
+
+	results := &eventResults{}
+	r.WGProcessTriggers.Add(len(matchedTriggers))
+	r.InFlight.add(eventID, len(matchedTriggers))
+	results.wg.Add(len(matchedTriggers))
+	for _, t := range matchedTriggers {
+		t := *t
+		r.Workers.run(func() {
 			localRequest := request.Clone(request.Context())
 			emptyExtensions := make(map[string]interface{})
-			r.processTrigger(t, el, localRequest, event, eventID, log, emptyExtensions)
-		}(*t)
+			r.processTrigger(t, el, "", localRequest, event, eventID, log, emptyExtensions, func(res TriggerResult) {
+				results.add(res)
+				results.wg.Done()
+				r.InFlight.done(eventID)
+				r.WGProcessTriggers.Done()
+			})
+		})
 	}
 
 	// Process grouped triggers
-	for _, group := range el.Spec.TriggerGroups {
+	for _, group := range matchedGroups {
 		r.WGProcessTriggers.Add(1)
-		go func(g triggersv1.EventListenerTriggerGroup) {
+		r.InFlight.add(eventID, 1)
+		results.wg.Add(1)
+		r.Workers.run(func() {
 			defer r.WGProcessTriggers.Done()
+			defer r.InFlight.done(eventID)
+			defer results.wg.Done()
 			localRequest := request.Clone(request.Context())
-			r.processTriggerGroups(g, el, localRequest, event, eventID, log, r.WGProcessTriggers)
-		}(group)
+			r.processTriggerGroups(group, el, localRequest, event, eventID, log, r.WGProcessTriggers, results)
+		})
+	}
+
+	if r.EventStore != nil {
+		go func() {
+			results.wg.Wait()
+			r.EventStore.setTriggers(eventID, results.list())
+		}()
+	}
+
+	// A delivery whose processing failed is released, so that the retries of
+	// its sender are processed rather than skipped
+	var released chan struct{}
+	if deliveryKey != "" {
+		released = make(chan struct{})
+		r.WGProcessTriggers.Add(1)
+		go func() {
+			defer r.WGProcessTriggers.Done()
+			defer close(released)
+			results.wg.Wait()
+			if results.failed() {
+				r.releaseDelivery(context.WithoutCancel(request.Context()), deliveryKey, eventID, log)
+			}
+		}()
 	}
 
 	r.recordCountMetrics(successTag)
@@ -222,9 +401,30 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 		EventID:          eventID,
 	}
 
+	statusCode := http.StatusAccepted
+	if sync, timeout := r.syncResponse(el, request); sync {
+		switch {
+		case isMessage(request.Context()):
+			if results.waitContext(request.Context()) {
+				statusCode = http.StatusOK
+			} else {
+				body.ErrorMessage = fmt.Sprintf("stopped waiting for triggers to be processed: %v", request.Context().Err())
+			}
+		case results.wait(timeout):
+			statusCode = http.StatusOK
+		default:
+			body.ErrorMessage = fmt.Sprintf("timed out after %s waiting for triggers to be processed", timeout)
+		}
+		if statusCode == http.StatusOK && released != nil {
+			// The sender may retry a failed delivery as soon as it gets the response
+			<-released
+		}
+		body.Triggers = results.list()
+	}
+
 	msg := cehttp.NewMessageFromHttpRequest(request)
 	if encoding := msg.ReadEncoding(); encoding == binding.EncodingUnknown {
-		response.WriteHeader(http.StatusAccepted)
+		response.WriteHeader(statusCode)
 		response.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(response).Encode(body); err != nil {
 			log.Errorf("failed to write back sink response: %v", err)
@@ -247,7 +447,7 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 			}
 		}()
 
-		if err := cehttp.WriteResponseWriter(request.Context(), eventResponse, http.StatusAccepted, response); err != nil {
+		if err := cehttp.WriteResponseWriter(request.Context(), eventResponse, statusCode, response); err != nil {
 			log.Errorf("failed to write back cloud event sink response: %v", err)
 			r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
 			r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
@@ -292,6 +492,25 @@ func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.
 				r.Logger.Errorf("Error getting Trigger %s in Namespace %s: %s", t.TriggerRef, r.EventListenerNamespace, err)
 				continue
 			}
+			if t.Match != nil || t.RetryPolicy != nil || t.Concurrency != nil || t.Debounce != nil || t.Schedule != nil {
+				// Do not modify the Trigger held by the lister cache
+				trig = trig.DeepCopy()
+				if t.Match != nil {
+					trig.Spec.Match = t.Match
+				}
+				if t.RetryPolicy != nil {
+					trig.Spec.RetryPolicy = t.RetryPolicy
+				}
+				if t.Concurrency != nil {
+					trig.Spec.Concurrency = t.Concurrency
+				}
+				if t.Debounce != nil {
+					trig.Spec.Debounce = t.Debounce
+				}
+				if t.Schedule != nil {
+					trig.Spec.Schedule = t.Schedule
+				}
+			}
 			triggers = append(triggers, trig)
 		case t.Template != nil:
 			triggers = append(triggers, &triggersv1.Trigger{
@@ -303,6 +522,11 @@ func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.
 					Bindings:           t.Bindings,
 					Template:           *t.Template,
 					Interceptors:       t.Interceptors,
+					Match:              t.Match,
+					RetryPolicy:        t.RetryPolicy,
+					Concurrency:        t.Concurrency,
+					Debounce:           t.Debounce,
+					Schedule:           t.Schedule,
 				},
 			})
 		default:
@@ -312,13 +536,59 @@ func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.
 	return triggers, nil
 }
 
-func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, wg *sync.WaitGroup) {
+// isDuplicate records the delivery of the event if the EventListener configures
+// deduplication, and returns the ID of the original event if the delivery was
+// already received. It also returns the key of the delivery it recorded, if
+// any. Errors are logged and the event is processed, since dropping an event
+// is worse than processing it twice.
+func (r Sink) isDuplicate(el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, log *zap.SugaredLogger) (string, string, bool) {
+	if el.Spec.Deduplication == nil || r.DeliveryStore == nil {
+		return "", "", false
+	}
+	// Replays are requested explicitly and are never skipped
+	if _, ok := replayFromContext(request.Context()); ok {
+		return "", "", false
+	}
+	key, err := template.ResolveValue(el.Spec.Deduplication.Key, event, request.Header, nil, template.NewTriggerContext(eventID))
+	if err != nil {
+		log.Warnf("unable to resolve deduplication key, processing event: %v", err)
+		return "", "", false
+	}
+	originalEventID, duplicate, err := r.DeliveryStore.Record(request.Context(), el, key, eventID, el.Spec.Deduplication.GetTTL())
+	if err != nil {
+		log.Errorf("unable to record delivery %s, processing event: %v", key, err)
+		return "", "", false
+	}
+	if duplicate {
+		return "", originalEventID, true
+	}
+	return key, "", false
+}
+
+// releaseDelivery releases the delivery recorded for the event, whose
+// processing failed. Errors are logged, and the retries of the delivery are
+// then skipped until it expires.
+func (r Sink) releaseDelivery(ctx context.Context, key, eventID string, log *zap.SugaredLogger) {
+	if err := r.DeliveryStore.Release(ctx, key, eventID); err != nil {
+		log.Errorf("unable to release delivery %s of failed event: %v", key, err)
+		return
+	}
+	log.Infof("released delivery %s, whose processing failed, so that it can be retried", key)
+}
+
+func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, wg *sync.WaitGroup, results *eventResults) {
 	log := eventLog.With(zap.String(triggers.TriggerGroupLabelKey, g.Name))
+	groupResult := TriggerResult{
+		Namespace:    r.EventListenerNamespace,
+		TriggerGroup: g.Name,
+	}
 
 	extensions := map[string]interface{}{}
 	payload, header, resp, err := r.ExecuteInterceptors(g.Interceptors, request, event, log, eventID, fmt.Sprintf("namespaces/%s/triggerGroups/%s", r.EventListenerNamespace, g.Name), r.EventListenerNamespace, extensions)
 	if err != nil {
 		log.Error(err)
+		groupResult.Error = err.Error()
+		results.add(groupResult)
 		return
 	}
 	if resp != nil {
@@ -329,14 +599,20 @@ func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *t
 		}
 		if !resp.Continue {
 			eventLog.Debugf("interceptor stopped trigger processing: %v", resp.Status.Err())
+			groupResult.Stopped = true
+			groupResult.Status = &resp.Status
+			results.add(groupResult)
 			return
 		}
 	}
 
 	trItems, err := r.selectTriggers(g.TriggerSelector.NamespaceSelector, g.TriggerSelector.LabelSelector)
 	if err != nil {
+		groupResult.Error = err.Error()
+		results.add(groupResult)
 		return
 	}
+	trItems = matchTriggers(trItems, request)
 
 	// Create a new HTTP request that contains the body and header from any interceptors in the TriggerGroup
 	// This request will be passed on to the triggers in this group
@@ -345,15 +621,49 @@ func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *t
 	triggerReq.Body = io.NopCloser(bytes.NewBuffer(payload))
 
 	wg.Add(len(trItems))
+	r.InFlight.add(eventID, len(trItems))
+	results.wg.Add(len(trItems))
+	// The selected triggers are queued rather than run by the worker of the
+	// group, which is released when the group returns
+	r.Workers.enqueue(len(trItems))
 	for _, t := range trItems {
-		go func(t triggersv1.Trigger) {
-			defer wg.Done()
+		t := *t
+		r.Workers.run(func() {
 			// TODO(dibyom): We might be able to get away with only cloning if necessary
 			// i.e. if there are interceptors and iff those interceptors will modify the body/header (i.e. webhook)
 			localRequest := triggerReq.Clone(triggerReq.Context())
-			r.processTrigger(t, el, localRequest, event, eventID, log, extensions)
-		}(*t)
+			r.processTrigger(t, el, g.Name, localRequest, event, eventID, log, extensions, func(res TriggerResult) {
+				results.add(res)
+				results.wg.Done()
+				r.InFlight.done(eventID)
+				wg.Done()
+			})
+		})
+	}
+}
+
+// matchTriggers returns the triggers that match the path and method of the
+// request, and the trigger it replays, if any. Triggers with a schedule only
+// match their scheduled events, and the replays of these events.
+func matchTriggers(trs []*triggersv1.Trigger, request *http.Request) []*triggersv1.Trigger {
+	rp, _ := replayFromContext(request.Context())
+	scheduled := scheduledTriggerFromContext(request.Context())
+	matched := make([]*triggersv1.Trigger, 0, len(trs))
+	for _, t := range trs {
+		if rp.trigger != "" && t.Name != rp.trigger {
+			continue
+		}
+		if scheduled != "" || t.Spec.Schedule != nil {
+			if t.Name == scheduled || (t.Spec.Schedule != nil && t.Name == rp.trigger) {
+				matched = append(matched, t)
+			}
+			continue
+		}
+		if t.Spec.Match.Matches(request) {
+			matched = append(matched, t)
+		}
 	}
+	return matched
 }
 
 func (r Sink) selectTriggers(namespaceSelector triggersv1.NamespaceSelector, labelSelector *metav1.LabelSelector) ([]*triggersv1.Trigger, error) {
@@ -405,18 +715,45 @@ func (r Sink) selectTriggers(namespaceSelector triggersv1.NamespaceSelector, lab
 	return trItems, nil
 }
 
-func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, extensions map[string]interface{}) {
+// processTrigger processes the event for a single Trigger, selected by the
+// TriggerGroup group if it is not empty, and calls done with the outcome. The
+// outcome is also recorded in a TriggerRun if the EventListener records them.
+// An event held by its debounce, or waiting for its turn in a concurrency
+// group, releases its worker, and done is called by the worker processing it
+// once it is released.
+func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener, group string, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, extensions map[string]interface{}, done func(TriggerResult)) {
 	log := eventLog.With(zap.String(triggers.TriggerLabelKey, t.Name))
+	result := TriggerResult{
+		Trigger:      t.Name,
+		Namespace:    t.Namespace,
+		TriggerGroup: group,
+	}
 
-	finalPayload, header, iresp, err := r.ExecuteTriggerInterceptors(t, request, event, log, eventID, extensions)
-	if err != nil {
+	rec := &triggerRunRecord{recordParams: el.Spec.TriggerRuns != nil && el.Spec.TriggerRuns.RecordParams}
+	triggerRun := r.startTriggerRun(el, t, group, eventID, log)
+	complete := func() {
+		r.completeTriggerRun(triggerRun, result, rec, log)
+		r.Decisions.record(eventID, result, rec.interceptors)
+		done(result)
+	}
+	fail := func(err error) {
 		log.Error(err)
+		result.Error = err.Error()
+		complete()
+	}
+
+	finalPayload, header, iresp, err := r.executeInterceptors(t.Spec.Interceptors, request, event, log, eventID, fmt.Sprintf("namespaces/%s/triggers/%s", t.Namespace, t.Name), t.Namespace, extensions, rec.observeInterceptor)
+	if err != nil {
+		fail(err)
 		return
 	}
 
 	if iresp != nil {
 		if !iresp.Continue {
 			log.Debugf("interceptor stopped trigger processing: %v", iresp.Status.Err())
+			result.Stopped = true
+			result.Status = &iresp.Status
+			complete()
 			return
 		}
 	}
@@ -426,7 +763,7 @@ func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener,
 		r.ClusterTriggerBindingLister.Get,
 		r.TriggerTemplateLister.TriggerTemplates(t.Namespace).Get)
 	if err != nil {
-		log.Error(err)
+		fail(err)
 		return
 	}
 	if iresp != nil && iresp.Extensions != nil {
@@ -434,20 +771,125 @@ func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener,
 	}
 	params, err := template.ResolveParams(rt, finalPayload, header, extensions, template.NewTriggerContext(eventID))
 	if err != nil {
-		log.Error(err)
+		fail(err)
 		return
 	}
 
 	log.Infof("ResolvedParams : %+v", params)
+	rec.params = params
+
 	resources := template.ResolveResources(rt.TriggerTemplate, params)
 
-	if err := r.CreateResources(t.Namespace, t.Spec.ServiceAccountName, resources, t.Name, eventID, log); err != nil {
-		log.Error(err)
+	create := func(resources []json.RawMessage) {
+		policy := retryPolicy(t, el)
+		created, err := r.createResources(t.Namespace, t.Spec.ServiceAccountName, resources, t.Name, eventID, log, policy, rt.TriggerTemplate.Spec.Transaction)
+		for _, obj := range created {
+			result.Resources = append(result.Resources, newResourceReference(obj))
+		}
+		if err != nil {
+			notCreated := resources[len(created):]
+			if rt.TriggerTemplate.Spec.Transaction != nil {
+				// The resources of a transaction are sent all together
+				notCreated = resources
+			}
+			if dlErr := r.sendDeadLetter(policy, t, el, deadLetter{
+				EventListener: r.EventListenerName,
+				Namespace:     t.Namespace,
+				Trigger:       t.Name,
+				EventID:       eventID,
+				Error:         err.Error(),
+				Header:        r.EventStore.redact(request.Header),
+				Body:          event,
+				Resources:     notCreated,
+			}); dlErr != nil {
+				log.Error(dlErr)
+			}
+			fail(err)
+			return
+		}
+		go r.recordResourceCreation(resources)
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingSuccessfulV1, nil)
+		r.sendCloudEvents(request.Header, *el, eventID, events.TriggerProcessingSuccessfulV1)
+		complete()
+	}
+
+	// proceed creates the resources, in the turn of the concurrency group of
+	// the event if the Trigger has one
+	proceed := func() { create(resources) }
+	if c := t.Spec.Concurrency; c != nil {
+		key, err := template.ResolveValue(c.Key, finalPayload, header, extensions, template.NewTriggerContext(eventID))
+		if err != nil {
+			fail(fmt.Errorf("failed to resolve concurrency key: %w", err))
+			return
+		}
+		group := concurrencyGroupLabel(key)
+		// The group is held, in this replica and in its Lease, until the
+		// resources are created so that the next event of the group finds them
+		proceed = func() {
+			r.ConcurrencyGroups.run(t.Namespace+"/"+t.Name+"/"+group, &queuedEvent{
+				eventID: eventID,
+				timeout: c.GetQueueTimeout(),
+				process: func() bool {
+					held, release, err := r.holdConcurrencyGroup(context.Background(), el, t, group, eventID)
+					if err != nil {
+						fail(err)
+						return false
+					}
+					if !held {
+						log.Infof("waiting for another replica to create the resources of concurrency group %s", group)
+						return true
+					}
+					defer release()
+					outcome, err := r.enforceConcurrency(c, t, group, resources, log)
+					switch {
+					case err != nil:
+						fail(err)
+					case outcome == concurrencyWait:
+						return true
+					case outcome == concurrencySkip:
+						result.Skipped = true
+						complete()
+					default:
+						labelled, err := withConcurrencyGroupLabel(resources, group)
+						if err != nil {
+							fail(err)
+							return false
+						}
+						create(labelled)
+					}
+					return false
+				},
+				drop: fail,
+			}, c.GetMaxQueued(), r.resume)
+		}
+	}
+
+	if d := t.Spec.Debounce; d != nil {
+		key, err := template.ResolveValue(d.Key, finalPayload, header, extensions, template.NewTriggerContext(eventID))
+		if err != nil {
+			fail(fmt.Errorf("failed to resolve debounce key: %w", err))
+			return
+		}
+		r.Debouncer.hold(t.Namespace+"/"+t.Name+"/"+key, d.Window.Duration, &pendingEvent{
+			eventID: eventID,
+			process: proceed,
+			supersede: func() {
+				log.Infof("event superseded by a newer event with debounce key %s", key)
+				result.Superseded = true
+				complete()
+			},
+			drop: fail,
+		}, r.resume)
 		return
 	}
-	go r.recordResourceCreation(resources)
-	r.emitEvents(r.EventRecorder, el, events.TriggerProcessingSuccessfulV1, nil)
-	r.sendCloudEvents(request.Header, *el, eventID, events.TriggerProcessingSuccessfulV1)
+	proceed()
+}
+
+// resume processes f in a new worker. It is used for the events held outside
+// of the workers, once they can be processed again.
+func (r Sink) resume(f func()) {
+	r.Workers.enqueue(1)
+	r.Workers.run(f)
 }
 
 func (r Sink) ExecuteTriggerInterceptors(t triggersv1.Trigger, in *http.Request, event []byte, log *zap.SugaredLogger, eventID string, extensions map[string]interface{}) ([]byte, http.Header, *triggersv1.InterceptorResponse, error) {
@@ -457,6 +899,16 @@ func (r Sink) ExecuteTriggerInterceptors(t triggersv1.Trigger, in *http.Request,
 // ExecuteInterceptor executes all interceptors for the Trigger and returns back the body, header, and InterceptorResponse to use.
 // When TEP-0022 is fully implemented, this function will only return the InterceptorResponse and error.
 func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *http.Request, event []byte, log *zap.SugaredLogger, eventID string, triggerID string, namespace string, extensions map[string]interface{}) ([]byte, http.Header, *triggersv1.InterceptorResponse, error) {
+	return r.executeInterceptors(trInt, in, event, log, eventID, triggerID, namespace, extensions, nil)
+}
+
+// executeInterceptors executes the interceptors like ExecuteInterceptors, and
+// calls observe, if not nil, with the outcome of each interceptor called.
+// Webhook interceptors are observed with the name "webhook".
+func (r Sink) executeInterceptors(trInt []*triggersv1.TriggerInterceptor, in *http.Request, event []byte, log *zap.SugaredLogger, eventID string, triggerID string, namespace string, extensions map[string]interface{}, observe func(name string, resp *triggersv1.InterceptorResponse, err error)) ([]byte, http.Header, *triggersv1.InterceptorResponse, error) {
+	if observe == nil {
+		observe = func(string, *triggersv1.InterceptorResponse, error) {}
+	}
 	if len(trInt) == 0 {
 		return event, in.Header, nil, nil
 	}
@@ -465,6 +917,7 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 	// or add to the Extensions
 	request := triggersv1.InterceptorRequest{
 		Body:       string(event),
+		RawBody:    rawBodyFromContext(in.Context()),
 		Header:     in.Header.Clone(),
 		Extensions: make(map[string]interface{}),
 		Context: &triggersv1.TriggerContext{
@@ -516,6 +969,7 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 			}
 			interceptor := webhook.NewInterceptor(i.Webhook, r.HTTPClient, namespace, log)
 			res, err := interceptor.ExecuteTrigger(req)
+			observe("webhook", nil, err)
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -533,39 +987,18 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 		}
 		request.InterceptorParams = interceptors.GetInterceptorParams(i)
 
-		var url *apis.URL
-		if i.Ref.Kind == triggersv1.ClusterInterceptorKind {
-			ic, err := r.ClusterInterceptorLister.Get(i.GetName())
-			if err != nil {
-				return nil, nil, nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
-			}
-			if ic.Status.Address != nil && ic.Status.Address.URL != nil {
-				url = ic.Status.Address.URL
-			} else if url, err = ic.ResolveAddress(); err != nil {
-				return nil, nil, nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
-			}
-			if err != nil {
-				return nil, nil, nil, fmt.Errorf("could not resolve clusterinterceptor URL: %w", err)
-			}
-		} else if i.Ref.Kind == triggersv1.NamespacedInterceptorKind {
-			if r.InterceptorLister == nil {
-				r.Logger.Debugf("nil lister")
-			}
-			ic, err := r.InterceptorLister.Interceptors(r.EventListenerNamespace).Get(i.GetName())
-			if err != nil {
-				return nil, nil, nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
-			}
-			if addr := ic.Status.Address; addr != nil && addr.URL != nil {
-				url = addr.URL
-			} else if url, err = ic.ResolveAddress(); err != nil {
-				return nil, nil, nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
-			}
-			if err != nil {
-				return nil, nil, nil, fmt.Errorf("could not resolve nameSpacedinterceptor URL: %w", err)
+		var interceptorResponse *triggersv1.InterceptorResponse
+		var err error
+		if path, ok := r.coreInterceptorPath(i); ok {
+			interceptorResponse, err = r.CoreInterceptors.Execute(context.Background(), path, &request)
+		} else {
+			var url *apis.URL
+			if url, err = r.interceptorURL(i); err != nil {
+				return nil, nil, nil, err
 			}
+			interceptorResponse, err = interceptors.Execute(context.Background(), r.HTTPClient, &request, url.String())
 		}
-
-		interceptorResponse, err := interceptors.Execute(context.Background(), r.HTTPClient, &request, url.String())
+		observe(i.GetName(), interceptorResponse, err)
 		if err != nil {
 			return nil, nil, nil, err
 		}
@@ -590,30 +1023,154 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 	}, nil
 }
 
-func (r Sink) CreateResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, log *zap.SugaredLogger) error {
-	discoveryClient := r.DiscoveryClient
-	dynamicClient := r.DynamicClient
-	var err error
-	if len(sa) > 0 {
-		// So at start up the discovery and dynamic clients are created using the in cluster config
-		// of this pod (i.e. using the credentials of the serviceaccount associated with the EventListener)
+// IsCoreInterceptor returns true if the ClusterInterceptor is served by the
+// core interceptors service.
+func IsCoreInterceptor(ic *triggersv1alpha1.ClusterInterceptor) bool {
+	svc := ic.Spec.ClientConfig.Service
+	return svc != nil && svc.Name == interceptors.CoreInterceptorsHost
+}
 
-		// However, we also have a ServiceAccountName reference with each EventListenerTrigger to allow
-		// for more fine grained authorization control around the resources we create below.
-		discoveryClient, dynamicClient, err = r.Auth.OverrideAuthentication(sa, triggerNS, log, r.DiscoveryClient, r.DynamicClient)
+// coreInterceptorPath returns the path of the core interceptor referenced by
+// the interceptor, and true if it runs in-process.
+func (r Sink) coreInterceptorPath(i *triggersv1.TriggerInterceptor) (string, bool) {
+	if r.CoreInterceptors == nil || i.Ref.Kind != triggersv1.ClusterInterceptorKind {
+		return "", false
+	}
+	// The errors are returned when the URL of the interceptor is resolved
+	ic, err := r.ClusterInterceptorLister.Get(i.GetName())
+	if err != nil || !IsCoreInterceptor(ic) {
+		return "", false
+	}
+	path := ic.Spec.ClientConfig.Service.Path
+	return path, r.CoreInterceptors.Handles(path)
+}
+
+// interceptorURL resolves the URL of the ClusterInterceptor or namespaced
+// Interceptor referenced by the interceptor.
+func (r Sink) interceptorURL(i *triggersv1.TriggerInterceptor) (*apis.URL, error) {
+	switch i.Ref.Kind {
+	case triggersv1.ClusterInterceptorKind:
+		ic, err := r.ClusterInterceptorLister.Get(i.GetName())
 		if err != nil {
-			log.Errorf("problem cloning rest config: %#v", err)
-			return err
+			return nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
+		}
+		if ic.Status.Address != nil && ic.Status.Address.URL != nil {
+			return ic.Status.Address.URL, nil
 		}
+		url, err := ic.ResolveAddress()
+		if err != nil {
+			return nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
+		}
+		return url, nil
+	case triggersv1.NamespacedInterceptorKind:
+		ic, err := r.InterceptorLister.Interceptors(r.EventListenerNamespace).Get(i.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
+		}
+		if addr := ic.Status.Address; addr != nil && addr.URL != nil {
+			return addr.URL, nil
+		}
+		url, err := ic.ResolveAddress()
+		if err != nil {
+			return nil, fmt.Errorf("url resolution failed for interceptor %s with: %w", i.GetName(), err)
+		}
+		return url, nil
 	}
+	return nil, nil
+}
+
+func (r Sink) CreateResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, log *zap.SugaredLogger) error {
+	_, err := r.createResources(triggerNS, sa, res, triggerName, eventID, log, nil, nil)
+	return err
+}
 
+// createResources creates the resources, retrying according to the policy,
+// and returns the ones that were created successfully, even if a later
+// resource failed to be created. With a transaction, the resources that were
+// created are deleted when a later resource fails to be created, and none is
+// returned unless they could not all be deleted.
+func (r Sink) createResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, log *zap.SugaredLogger, policy *triggersv1.RetryPolicy, tx *triggersv1.Transaction) ([]*unstructured.Unstructured, error) {
+	discoveryClient, dynamicClient, err := r.clientsFor(sa, triggerNS, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx != nil && tx.DryRun {
+		for _, rr := range res {
+			if err := resources.DryRunCreate(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient); err != nil {
+				log.Errorf("problem creating obj with a dry-run: %#v", err)
+				return nil, fmt.Errorf("dry-run failed, no resource was created: %w", err)
+			}
+		}
+	}
+
+	created := make([]*unstructured.Unstructured, 0, len(res))
 	for _, rr := range res {
-		if err := resources.Create(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient); err != nil {
-			log.Errorf("problem creating obj: %#v", err)
+		var obj *unstructured.Unstructured
+		err := withRetries(policy, func() error {
+			var err error
+			obj, err = resources.Create(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient)
+			if err != nil {
+				log.Debugf("attempt to create obj failed: %v", err)
+			}
 			return err
+		})
+		if err != nil {
+			log.Errorf("problem creating obj: %#v", err)
+			if tx != nil {
+				return r.rollback(created, res, err, discoveryClient, dynamicClient, log)
+			}
+			return created, err
+		}
+		created = append(created, obj)
+	}
+	return created, nil
+}
+
+// clientsFor returns the discovery and dynamic clients managing the resources
+// of a Trigger, with the credentials of its service account if it has one.
+func (r Sink) clientsFor(sa, triggerNS string, log *zap.SugaredLogger) (discoveryclient.ServerResourcesInterface, dynamic.Interface, error) {
+	if len(sa) == 0 {
+		return r.DiscoveryClient, r.DynamicClient, nil
+	}
+	// So at start up the discovery and dynamic clients are created using the in cluster config
+	// of this pod (i.e. using the credentials of the serviceaccount associated with the EventListener)
+
+	// However, we also have a ServiceAccountName reference with each EventListenerTrigger to allow
+	// for more fine grained authorization control around the resources we create below.
+	discoveryClient, dynamicClient, err := r.Auth.OverrideAuthentication(sa, triggerNS, log, r.DiscoveryClient, r.DynamicClient)
+	if err != nil {
+		log.Errorf("problem cloning rest config: %#v", err)
+		return nil, nil, err
+	}
+	return discoveryClient, dynamicClient, nil
+}
+
+// rollback deletes the resources created for a transaction that failed with
+// err, in the reverse order of their creation. It returns the resources that
+// could not be deleted, if any. The resources that were applied, patched or
+// deleted rather than created cannot be rolled back.
+func (r Sink) rollback(created []*unstructured.Unstructured, res []json.RawMessage, err error, discoveryClient discoveryclient.ServerResourcesInterface, dynamicClient dynamic.Interface, log *zap.SugaredLogger) ([]*unstructured.Unstructured, error) {
+	var left []*unstructured.Unstructured
+	errs := []error{err}
+	for i := len(created) - 1; i >= 0; i-- {
+		if action := resources.Action(res[i]); action != triggersv1.ResourceActionCreate {
+			log.Warnf("cannot roll back the %s of %s %s", action, created[i].GetKind(), created[i].GetName())
+			continue
+		}
+		if delErr := resources.Delete(r.Logger, created[i], discoveryClient, dynamicClient); delErr != nil {
+			log.Errorf("problem rolling back obj: %#v", delErr)
+			left = append([]*unstructured.Unstructured{created[i]}, left...)
+			errs = append(errs, delErr)
 		}
 	}
-	return nil
+	if len(left) > 0 {
+		return left, fmt.Errorf("failed to roll back %d resources: %w", len(left), errors.Join(errs...))
+	}
+	if len(created) > 0 {
+		return nil, fmt.Errorf("%w, deleted the %d resources created", err, len(created))
+	}
+	return nil, err
 }
 
 // extendBodyWithExtensions merges the extensions into the given body.
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -29,7 +30,9 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	cloudeventstest "github.com/cloudevents/sdk-go/v2/client/test"
@@ -58,9 +61,11 @@ import (
 	"go.uber.org/zap/zaptest"
 	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
@@ -1434,6 +1439,543 @@ func TestHandleEvent_Error(t *testing.T) {
 	}
 }
 
+func TestHandleEvent_PathMatching(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	makeTrigger := func(name string, match *triggersv1beta1.RequestMatch) triggersv1beta1.EventListenerTrigger {
+		return triggersv1beta1.EventListenerTrigger{
+			Name: name,
+			Bindings: []*triggersv1beta1.EventListenerBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+				{Name: "name", Value: ptr.String(name + "-run")},
+			},
+			Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, name+"-run")},
+			Match:    match,
+		}
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{
+				makeTrigger("github", &triggersv1beta1.RequestMatch{Path: "/github"}),
+				makeTrigger("gitlab", &triggersv1beta1.RequestMatch{Path: "/gitlab", Methods: []string{http.MethodPost}}),
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		method         string
+		path           string
+		wantStatusCode int
+		wantRuns       []string
+	}{{
+		name:           "matches github trigger",
+		method:         http.MethodPost,
+		path:           "/github",
+		wantStatusCode: http.StatusAccepted,
+		wantRuns:       []string{"github-run"},
+	}, {
+		name:           "matches gitlab trigger with trailing slash",
+		method:         http.MethodPost,
+		path:           "/gitlab/",
+		wantStatusCode: http.StatusAccepted,
+		wantRuns:       []string{"gitlab-run"},
+	}, {
+		name:           "method does not match",
+		method:         http.MethodPut,
+		path:           "/gitlab",
+		wantStatusCode: http.StatusNotFound,
+	}, {
+		name:           "path does not match",
+		method:         http.MethodPost,
+		path:           "/bitbucket",
+		wantStatusCode: http.StatusNotFound,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el.DeepCopy()}}, el.Name, nil)
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+
+			req, err := http.NewRequest(tc.method, ts.URL+tc.path, bytes.NewReader(eventBody))
+			if err != nil {
+				t.Fatalf("error creating request: %s", err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			if resp.StatusCode != tc.wantStatusCode {
+				t.Fatalf("Status code mismatch: got %d, want %d", resp.StatusCode, tc.wantStatusCode)
+			}
+			sink.WGProcessTriggers.Wait()
+
+			var gotRuns []string
+			for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+				gotRuns = append(gotRuns, tr.Name)
+			}
+			if diff := cmp.Diff(tc.wantRuns, gotRuns); diff != "" {
+				t.Errorf("Created resources mismatch (-want + got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_SyncResponse(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+					{Name: "name", Value: ptr.String("git-clone-run")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+			}, {
+				Name:     "missing-template-trigger",
+				Template: &triggersv1beta1.EventListenerTemplate{Ref: ptr.String("does-not-exist")},
+			}},
+		},
+	}
+
+	syncTriggers := []TriggerResult{{
+		Trigger:   "git-clone-trigger",
+		Namespace: namespace,
+		Resources: []ResourceReference{{
+			APIVersion: "tekton.dev/v1",
+			Kind:       "TaskRun",
+			Namespace:  namespace,
+			Name:       "git-clone-run",
+		}},
+	}, {
+		Trigger:   "missing-template-trigger",
+		Namespace: namespace,
+		Error:     `error getting TriggerTemplate does-not-exist: triggertemplate.triggers.tekton.dev "does-not-exist" not found`,
+	}}
+
+	for _, tc := range []struct {
+		name           string
+		header         string
+		query          string
+		response       *triggersv1beta1.Response
+		wantStatusCode int
+		wantTriggers   []TriggerResult
+	}{{
+		name:           "asynchronous by default",
+		wantStatusCode: http.StatusAccepted,
+	}, {
+		name:           "synchronous via header",
+		header:         SyncResponseMode,
+		wantStatusCode: http.StatusOK,
+		wantTriggers:   syncTriggers,
+	}, {
+		name:           "synchronous via query parameter",
+		query:          "?responseMode=sync",
+		wantStatusCode: http.StatusOK,
+		wantTriggers:   syncTriggers,
+	}, {
+		name:           "synchronous via the EventListener",
+		response:       &triggersv1beta1.Response{Mode: triggersv1beta1.ResponseModeSync},
+		wantStatusCode: http.StatusOK,
+		wantTriggers:   syncTriggers,
+	}, {
+		name:           "asynchronous via header overrides the EventListener",
+		header:         AsyncResponseMode,
+		response:       &triggersv1beta1.Response{Mode: triggersv1beta1.ResponseModeSync},
+		wantStatusCode: http.StatusAccepted,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := el.DeepCopy()
+			el.Spec.Response = tc.response
+			sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+			sink.SyncResponseTimeout = 10 * time.Second
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodPost, ts.URL+tc.query, bytes.NewReader(eventBody))
+			if err != nil {
+				t.Fatalf("error creating request: %s", err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			if tc.header != "" {
+				req.Header.Add(ResponseModeHeader, tc.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			defer resp.Body.Close()
+			sink.WGProcessTriggers.Wait()
+
+			if resp.StatusCode != tc.wantStatusCode {
+				t.Fatalf("Status code mismatch: got %d, want %d", resp.StatusCode, tc.wantStatusCode)
+			}
+			var gotBody Response
+			if err := json.NewDecoder(resp.Body).Decode(&gotBody); err != nil {
+				t.Fatalf("Error reading response body: %s", err)
+			}
+			wantBody := Response{
+				EventListener:    el.Name,
+				EventListenerUID: elUID,
+				Namespace:        namespace,
+				EventID:          eventID,
+				Triggers:         tc.wantTriggers,
+			}
+			if diff := cmp.Diff(wantBody, gotBody); diff != "" {
+				t.Errorf("did not get expected response back -want,+got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_Deduplication(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+					{Name: "name", Value: ptr.String("git-clone-run")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+			}},
+			Deduplication: &triggersv1beta1.Deduplication{
+				Key: "$(header.X-Github-Delivery)",
+			},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.DeliveryStore = newInMemoryDeliveryStore()
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	for _, tc := range []struct {
+		name           string
+		delivery       string
+		wantStatusCode int
+		wantDuplicate  bool
+		wantRuns       int
+	}{{
+		name:           "first delivery",
+		delivery:       "72d3162e",
+		wantStatusCode: http.StatusAccepted,
+		wantRuns:       1,
+	}, {
+		name:           "repeated delivery",
+		delivery:       "72d3162e",
+		wantStatusCode: http.StatusOK,
+		wantDuplicate:  true,
+		wantRuns:       1,
+	}, {
+		name:           "new delivery",
+		delivery:       "a04b2f19",
+		wantStatusCode: http.StatusAccepted,
+		wantRuns:       2,
+	}, {
+		name:           "missing key is processed",
+		wantStatusCode: http.StatusAccepted,
+		wantRuns:       3,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader(eventBody))
+			if err != nil {
+				t.Fatalf("error creating request: %s", err)
+			}
+			req.Header.Add("Content-Type", "application/json")
+			if tc.delivery != "" {
+				req.Header.Add("X-GitHub-Delivery", tc.delivery)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			defer resp.Body.Close()
+			sink.WGProcessTriggers.Wait()
+
+			if resp.StatusCode != tc.wantStatusCode {
+				t.Fatalf("Status code mismatch: got %d, want %d", resp.StatusCode, tc.wantStatusCode)
+			}
+			var gotBody Response
+			if err := json.NewDecoder(resp.Body).Decode(&gotBody); err != nil {
+				t.Fatalf("Error reading response body: %s", err)
+			}
+			if gotBody.Duplicate != tc.wantDuplicate {
+				t.Errorf("Duplicate = %t, want %t", gotBody.Duplicate, tc.wantDuplicate)
+			}
+			if got := len(toTaskRun(t, dynamicClient.Actions())); got != tc.wantRuns {
+				t.Errorf("got %d TaskRuns, want %d", got, tc.wantRuns)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_DeduplicationOfFailedDeliveries(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+					{Name: "name", Value: ptr.String("git-clone-run")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+			}},
+			Deduplication: &triggersv1beta1.Deduplication{
+				Key: "$(header.X-Github-Delivery)",
+			},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.DeliveryStore = newInMemoryDeliveryStore()
+	// The resources of the first delivery cannot be created
+	var creates atomic.Int32
+	dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+		if creates.Add(1) == 1 {
+			return true, nil, kerrors.NewForbidden(schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}, "git-clone-run", errors.New("denied"))
+		}
+		return false, nil, nil
+	})
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	for _, responseMode := range []string{SyncResponseMode, "", ""} {
+		req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader(eventBody))
+		if err != nil {
+			t.Fatalf("error creating request: %s", err)
+		}
+		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("X-GitHub-Delivery", "72d3162e")
+		req.Header.Add(ResponseModeHeader, responseMode)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("error sending request: %s", err)
+		}
+		resp.Body.Close()
+		sink.WGProcessTriggers.Wait()
+	}
+
+	// The retry of the failed delivery is processed, and the delivery is then
+	// skipped
+	if got := creates.Load(); got != 2 {
+		t.Errorf("got %d creates, want the failed delivery to be processed again once", got)
+	}
+}
+
+func TestHandleEvent_QueueFull(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+					{Name: "name", Value: ptr.String("git-clone-run")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.Workers = NewWorkerPool(1, 0)
+	sink.RetryAfter = 30 * time.Second
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	send := func() *http.Response {
+		t.Helper()
+		resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(eventBody))
+		if err != nil {
+			t.Fatalf("error sending request: %s", err)
+		}
+		resp.Body.Close()
+		sink.WGProcessTriggers.Wait()
+		return resp
+	}
+
+	// Take the only place in the pool
+	sink.Workers.admit(1)
+	resp := send()
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Status code mismatch: got %d, want %d", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "30" {
+		t.Errorf("Retry-After = %q, want %q", got, "30")
+	}
+	if got := len(toTaskRun(t, dynamicClient.Actions())); got != 0 {
+		t.Errorf("got %d TaskRuns for a rejected event, want 0", got)
+	}
+
+	sink.Workers.cancel(1)
+	resp = send()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Status code mismatch: got %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	if got := len(toTaskRun(t, dynamicClient.Actions())); got != 1 {
+		t.Errorf("got %d TaskRuns, want 1", got)
+	}
+}
+
+func TestHandleEvent_Transaction(t *testing.T) {
+	eventBody := json.RawMessage(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	for _, tc := range []struct {
+		name        string
+		transaction *triggersv1beta1.Transaction
+		// failDryRun fails the dry-run of the second TaskRun rather than its creation
+		failDryRun  bool
+		wantCreates int
+		wantDeletes int
+		wantLeft    bool
+	}{{
+		name:        "without transaction",
+		wantCreates: 2,
+		wantLeft:    true,
+	}, {
+		name:        "rollback",
+		transaction: &triggersv1beta1.Transaction{},
+		wantCreates: 2,
+		wantDeletes: 1,
+	}, {
+		name:        "dry-run",
+		transaction: &triggersv1beta1.Transaction{DryRun: true},
+		failDryRun:  true,
+	}, {
+		name:        "dry-run succeeds and rollback",
+		transaction: &triggersv1beta1.Transaction{DryRun: true},
+		wantCreates: 2,
+		wantDeletes: 1,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := makeGitCloneTTSpec(t, "git-clone-run")
+			spec.ResourceTemplates = append(spec.ResourceTemplates, triggersv1beta1.TriggerResourceTemplate{
+				RawExtension: test.RawExtension(t, pipelinev1.TaskRun{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+					ObjectMeta: metav1.ObjectMeta{Name: "second-run", Namespace: namespace},
+					Spec:       pipelinev1.TaskRunSpec{TaskRef: &pipelinev1.TaskRef{Name: "git-clone"}},
+				}),
+			})
+			spec.Transaction = tc.transaction
+			el := &triggersv1beta1.EventListener{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-el",
+					Namespace: namespace,
+					UID:       types.UID(elUID),
+				},
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						Name: "git-clone-trigger",
+						Bindings: []*triggersv1beta1.EventListenerBinding{
+							{Name: "url", Value: ptr.String("$(body.repository.url)")},
+							{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+						},
+						Template: &triggersv1beta1.EventListenerTemplate{Spec: spec},
+					}},
+				},
+			}
+			sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+
+			var creates, dryRuns int
+			dynamicClient.PrependReactor("create", "taskruns", func(action ktesting.Action) (bool, runtime.Object, error) {
+				create := action.(ktesting.CreateActionImpl)
+				second := create.GetObject().(*unstructured.Unstructured).GetName() == "second-run"
+				if len(create.CreateOptions.DryRun) > 0 {
+					// The fake client does not implement dry-runs
+					dryRuns++
+					if second && tc.failDryRun {
+						return true, nil, kerrors.NewBadRequest("invalid TaskRun")
+					}
+					return true, create.GetObject(), nil
+				}
+				creates++
+				if second {
+					return true, nil, kerrors.NewBadRequest("invalid TaskRun")
+				}
+				return false, nil, nil
+			})
+
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(eventBody))
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			resp.Body.Close()
+			sink.WGProcessTriggers.Wait()
+
+			wantDryRuns := 0
+			if tc.transaction != nil && tc.transaction.DryRun {
+				wantDryRuns = 2
+			}
+			if creates != tc.wantCreates || dryRuns != wantDryRuns {
+				t.Errorf("got %d creates and %d dry-runs, want %d and %d", creates, dryRuns, tc.wantCreates, wantDryRuns)
+			}
+			var deletes int
+			for _, action := range dynamicClient.Actions() {
+				if action.GetVerb() == "delete" {
+					deletes++
+				}
+			}
+			if deletes != tc.wantDeletes {
+				t.Errorf("got %d deletes, want %d", deletes, tc.wantDeletes)
+			}
+			_, err = dynamicClient.Resource(gvr).Namespace(namespace).Get(context.Background(), "git-clone-run", metav1.GetOptions{})
+			if left := err == nil; left != tc.wantLeft {
+				t.Errorf("first TaskRun left behind = %t, want %t", left, tc.wantLeft)
+			}
+		})
+	}
+}
+
+func TestEventResultsWaitTimeout(t *testing.T) {
+	results := &eventResults{}
+	results.wg.Add(1)
+	if results.wait(10 * time.Millisecond) {
+		t.Errorf("wait() returned true while a trigger was still being processed")
+	}
+	results.add(TriggerResult{Trigger: "b"})
+	results.add(TriggerResult{Trigger: "a"})
+	results.wg.Done()
+	if !results.wait(time.Second) {
+		t.Errorf("wait() returned false after all triggers were processed")
+	}
+	want := []TriggerResult{{Trigger: "a"}, {Trigger: "b"}}
+	if diff := cmp.Diff(want, results.list()); diff != "" {
+		t.Errorf("list() mismatch (-want +got): %s", diff)
+	}
+}
+
 // sequentialInterceptor is a HTTP server that will return sequential responses.
 // It expects a request of the form `{"i": n}`.
 // The response body will always return with the next value set, whereas the
@@ -1626,6 +2168,68 @@ func TestExecuteInterceptor_NotContinue(t *testing.T) {
 	}
 }
 
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestExecuteInterceptor_InProcess(t *testing.T) {
+	celService := &triggersv1alpha1.ClusterInterceptor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cel-service",
+		},
+		Spec: triggersv1alpha1.ClusterInterceptorSpec{
+			ClientConfig: triggersv1alpha1.ClientConfig{
+				Service: &triggersv1alpha1.ServiceReference{
+					Name:      interceptors.CoreInterceptorsHost,
+					Namespace: "tekton-pipelines",
+					Path:      "cel",
+				},
+			},
+		},
+	}
+	resources := test.Resources{
+		ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{cel, celService},
+	}
+	s, _ := getSinkAssets(t, resources, "el-name", nil)
+	coreInterceptors, err := server.NewWithCoreInterceptors(interceptors.DefaultSecretGetter(s.KubeClientSet.CoreV1()), s.Logger)
+	if err != nil {
+		t.Fatalf("error initializing core interceptors: %v", err)
+	}
+	s.CoreInterceptors = coreInterceptors
+	// The interceptors called over HTTP fail
+	s.HTTPClient = &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("unexpected HTTP request")
+	})}
+
+	trigger := func(name string) triggersv1beta1.Trigger {
+		return triggersv1beta1.Trigger{
+			Spec: triggersv1beta1.TriggerSpec{
+				Interceptors: []*triggersv1beta1.EventInterceptor{{
+					Ref: triggersv1beta1.InterceptorRef{Name: name, Kind: triggersv1beta1.ClusterInterceptorKind},
+					Params: []triggersv1beta1.InterceptorParams{{
+						Name:  "filter",
+						Value: test.ToV1JSON(t, `body.head == "abcde"`),
+					}},
+				}}},
+		}
+	}
+	url, _ := url.Parse("http://example.com")
+	_, _, resp, err := s.ExecuteTriggerInterceptors(trigger("cel-service"), &http.Request{URL: url}, json.RawMessage(`{"head": "blah"}`), s.Logger, "eventID", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("ExecuteInterceptor() unexpected error: %v", err)
+	}
+	if resp == nil || resp.Continue {
+		t.Fatalf("ExecuteInterceptor() expected the in-process interceptor to not continue but got: %v", resp)
+	}
+
+	// The interceptors that are not served by the core interceptors service are called over HTTP
+	if _, _, _, err := s.ExecuteTriggerInterceptors(trigger("cel"), &http.Request{URL: url}, json.RawMessage(`{"head": "blah"}`), s.Logger, "eventID", map[string]interface{}{}); err == nil {
+		t.Fatal("ExecuteInterceptor() expected the interceptor to be called over HTTP")
+	}
+}
+
 // echoInterceptor stores and returns the body back
 type echoInterceptor struct {
 	body map[string]interface{}
@@ -2048,6 +2652,144 @@ func TestCloudEventHandling(t *testing.T) {
 	}
 }
 
+func TestCloudEventHandling_Structured(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "build-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(ce.extensions.revision)")},
+					{Name: "name", Value: ptr.String("$(ce.subject)")},
+					{Name: "type", Value: ptr.String("$(ce.type)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+
+	body := `{
+		"specversion": "1.0",
+		"id": "event-1",
+		"source": "/builds",
+		"type": "dev.tekton.build.requested",
+		"subject": "build-1",
+		"revision": "main",
+		"datacontenttype": "application/json",
+		"data": {"repository": {"url": "https://github.com/tektoncd/triggers"}}
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	rec := httptest.NewRecorder()
+	sink.HandleEvent(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusAccepted)
+	}
+	sink.WGProcessTriggers.Wait()
+
+	got := toTaskRun(t, dynamicClient.Actions())
+	if len(got) != 1 {
+		t.Fatalf("got %d TaskRuns, want 1", len(got))
+	}
+	if got[0].Name != "build-1" || got[0].Labels["type"] != "dev.tekton.build.requested" {
+		t.Errorf("got TaskRun %s with type %s, want the subject and type of the CloudEvent", got[0].Name, got[0].Labels["type"])
+	}
+	wantParams := pipelinev1.Params{{
+		Name:  "url",
+		Value: pipelinev1.ParamValue{Type: pipelinev1.ParamTypeString, StringVal: "https://github.com/tektoncd/triggers"},
+	}, {
+		Name:  "git-revision",
+		Value: pipelinev1.ParamValue{Type: pipelinev1.ParamTypeString, StringVal: "main"},
+	}}
+	if diff := cmp.Diff(wantParams, got[0].Spec.Params); diff != "" {
+		t.Errorf("params of the TaskRun (-want +got): %s", diff)
+	}
+}
+
+func TestCloudEventHandling_Batch(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "build-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.url)")},
+					{Name: "revision", Value: ptr.String("$(ce.id)")},
+					{Name: "name", Value: ptr.String("$(ce.subject)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	sink.EventStore = NewEventStore(10, nil)
+	ids := 0
+	template.UUID = func() string {
+		ids++
+		return fmt.Sprintf("event-id-%d", ids)
+	}
+	defer func() { template.UUID = func() string { return eventID } }()
+
+	body := `[{
+		"specversion": "1.0", "id": "event-1", "source": "/builds", "type": "build", "subject": "build-1",
+		"datacontenttype": "application/json", "data": {"url": "https://github.com/tektoncd/triggers"}
+	}, {
+		"specversion": "1.0", "id": "event-2", "source": "/builds", "type": "build", "subject": "build-2",
+		"datacontenttype": "text/plain", "data": "not json"
+	}, {
+		"specversion": "1.0", "id": "event-3", "source": "/builds", "type": "build", "subject": "build-3",
+		"datacontenttype": "application/json", "data": {"url": "https://github.com/tektoncd/pipeline"}
+	}]`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/cloudevents-batch+json")
+	rec := httptest.NewRecorder()
+	sink.IsValidPayload(http.HandlerFunc(sink.HandleEvent)).ServeHTTP(rec, req)
+	sink.WGProcessTriggers.Wait()
+
+	// The batch is rejected since one of its events was not accepted
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	var got BatchResponse
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatalf("error decoding the response: %v", err)
+	}
+	want := BatchResponse{
+		EventListener: "test-el",
+		Namespace:     namespace,
+		Events: []BatchEventResult{
+			{ID: "event-1", EventID: "event-id-1", Status: http.StatusAccepted, Accepted: true},
+			{ID: "event-2", Status: http.StatusBadRequest},
+			{ID: "event-3", EventID: "event-id-2", Status: http.StatusAccepted, Accepted: true},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(BatchEventResult{}, "ErrorMessage")); diff != "" {
+		t.Errorf("response (-want +got): %s", diff)
+	}
+
+	var names []string
+	for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+		names = append(names, tr.Name)
+	}
+	if diff := cmp.Diff([]string{"build-1", "build-3"}, names); diff != "" {
+		t.Errorf("TaskRuns (-want +got): %s", diff)
+	}
+	// Each event of the batch is recorded on its own
+	if got := len(sink.EventStore.list()); got != 2 {
+		t.Errorf("got %d recorded events, want 2", got)
+	}
+}
+
 func makeGitCloneTTSpec(t *testing.T, name string) *triggersv1beta1.TriggerTemplateSpec {
 	return &triggersv1beta1.TriggerTemplateSpec{
 		Params: []triggersv1beta1.ParamSpec{
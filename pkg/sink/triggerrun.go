@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/kmeta"
+)
+
+// triggerRunRecord collects what happens while a Trigger processes an event,
+// to be recorded in the status of its TriggerRun.
+type triggerRunRecord struct {
+	interceptors []triggersv1alpha1.InterceptorResult
+	params       []triggersv1.Param
+	// recordParams is whether the values of the params are recorded, since
+	// they may hold secrets of the event
+	recordParams bool
+}
+
+func (rec *triggerRunRecord) observeInterceptor(name string, resp *triggersv1.InterceptorResponse, err error) {
+	res := triggersv1alpha1.InterceptorResult{Name: name}
+	switch {
+	case err != nil:
+		res.Message = err.Error()
+	case resp != nil && !resp.Continue:
+		res.Message = resp.Status.Message
+	default:
+		res.Continue = true
+	}
+	rec.interceptors = append(rec.interceptors, res)
+}
+
+// startTriggerRun creates the TriggerRun of the Trigger for the event if the
+// EventListener records TriggerRuns, and marks it as running. Failing to
+// record it is only logged since it must not prevent the event from being
+// processed.
+func (r Sink) startTriggerRun(el *triggersv1.EventListener, t triggersv1.Trigger, group, eventID string, log *zap.SugaredLogger) *triggersv1alpha1.TriggerRun {
+	if el.Spec.TriggerRuns == nil || r.TriggersClient == nil {
+		return nil
+	}
+	tr := &triggersv1alpha1.TriggerRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: t.Name + "-",
+			Namespace:    el.Namespace,
+			Labels: map[string]string{
+				triggers.GroupName + triggers.EventListenerLabelKey: el.Name,
+				triggers.GroupName + triggers.EventIDLabelKey:       eventID,
+				triggers.GroupName + triggers.TriggerLabelKey:       t.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(el)},
+		},
+		Spec: triggersv1alpha1.TriggerRunSpec{
+			EventListener:    el.Name,
+			EventID:          eventID,
+			Trigger:          t.Name,
+			TriggerNamespace: t.Namespace,
+			TriggerGroup:     group,
+		},
+	}
+	if group != "" {
+		tr.Labels[triggers.GroupName+triggers.TriggerGroupLabelKey] = group
+	}
+	created, err := r.TriggersClient.TriggersV1alpha1().TriggerRuns(el.Namespace).Create(context.Background(), tr, metav1.CreateOptions{})
+	if err != nil {
+		log.Errorf("failed to create TriggerRun: %v", err)
+		return nil
+	}
+	created.Status.InitializeConditions()
+	created.Status.StartTime = &metav1.Time{Time: time.Now()}
+	running, err := r.TriggersClient.TriggersV1alpha1().TriggerRuns(el.Namespace).UpdateStatus(context.Background(), created, metav1.UpdateOptions{})
+	if err != nil {
+		log.Errorf("failed to update the status of TriggerRun %s: %v", created.Name, err)
+		return created
+	}
+	return running
+}
+
+// completeTriggerRun records the result of the Trigger in the status of its
+// TriggerRun, if any.
+func (r Sink) completeTriggerRun(tr *triggersv1alpha1.TriggerRun, result TriggerResult, rec *triggerRunRecord, log *zap.SugaredLogger) {
+	if tr == nil {
+		return
+	}
+	tr.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	tr.Status.Interceptors = rec.interceptors
+	if rec.recordParams {
+		for _, p := range rec.params {
+			tr.Status.Params = append(tr.Status.Params, triggersv1alpha1.Param{Name: p.Name, Value: p.Value})
+		}
+	}
+	for _, res := range result.Resources {
+		tr.Status.Resources = append(tr.Status.Resources, triggersv1alpha1.ResourceReference(res))
+	}
+	switch {
+	case result.Error != "":
+		tr.Status.MarkFailed(triggersv1alpha1.TriggerRunReasonFailed, "%s", result.Error)
+	case result.Stopped:
+		msg := ""
+		if result.Status != nil {
+			msg = result.Status.Message
+		}
+		tr.Status.MarkSucceeded(triggersv1alpha1.TriggerRunReasonInterceptorStopped, "%s", msg)
+	case result.Skipped:
+		tr.Status.MarkSucceeded(triggersv1alpha1.TriggerRunReasonSkipped, "resources of the concurrency group are running")
+	case result.Superseded:
+		tr.Status.MarkSucceeded(triggersv1alpha1.TriggerRunReasonSuperseded, "a newer event with the same debounce key was received")
+	default:
+		tr.Status.MarkSucceeded(triggersv1alpha1.TriggerRunReasonResourcesCreated, "%d resources created", len(result.Resources))
+	}
+	if _, err := r.TriggersClient.TriggersV1alpha1().TriggerRuns(tr.Namespace).UpdateStatus(context.Background(), tr, metav1.UpdateOptions{}); err != nil {
+		log.Errorf("failed to update the status of TriggerRun %s: %v", tr.Name, err)
+	}
+}
+
+// DeleteExpiredTriggerRuns deletes the TriggerRuns of the EventListener that
+// completed, or were created if they never completed, more than ttl ago.
+func (r Sink) DeleteExpiredTriggerRuns(ctx context.Context, ttl time.Duration) error {
+	triggerRuns := r.TriggersClient.TriggersV1alpha1().TriggerRuns(r.EventListenerNamespace)
+	list, err := triggerRuns.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			triggers.GroupName + triggers.EventListenerLabelKey: r.EventListenerName,
+		}).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range list.Items {
+		tr := &list.Items[i]
+		done := tr.CreationTimestamp.Time
+		if tr.Status.CompletionTime != nil {
+			done = tr.Status.CompletionTime.Time
+		}
+		if time.Since(done) < ttl {
+			continue
+		}
+		if err := triggerRuns.Delete(ctx, tr.Name, metav1.DeleteOptions{}); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestHandleEvent_TriggerRun(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		body             string
+		recordParams     bool
+		wantStatus       corev1.ConditionStatus
+		wantReason       string
+		wantInterceptors []triggersv1alpha1.InterceptorResult
+		wantParams       []triggersv1alpha1.Param
+		wantResources    []triggersv1alpha1.ResourceReference
+	}{{
+		name:         "resources created",
+		body:         `{"action": "opened", "head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`,
+		recordParams: true,
+		wantStatus:   corev1.ConditionTrue,
+		wantReason:   triggersv1alpha1.TriggerRunReasonResourcesCreated,
+		wantInterceptors: []triggersv1alpha1.InterceptorResult{
+			{Name: "cel", Continue: true},
+		},
+		wantParams: []triggersv1alpha1.Param{
+			{Name: "app", Value: "triggers"},
+			{Name: "type", Value: "bar"},
+			{Name: "url", Value: "testurl"},
+			{Name: "revision", Value: "testrevision"},
+			{Name: "name", Value: "git-clone-run"},
+		},
+		wantResources: []triggersv1alpha1.ResourceReference{
+			{APIVersion: "tekton.dev/v1", Kind: "TaskRun", Namespace: namespace, Name: "git-clone-run"},
+		},
+	}, {
+		name:       "params not recorded",
+		body:       `{"action": "opened", "head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`,
+		wantStatus: corev1.ConditionTrue,
+		wantReason: triggersv1alpha1.TriggerRunReasonResourcesCreated,
+		wantInterceptors: []triggersv1alpha1.InterceptorResult{
+			{Name: "cel", Continue: true},
+		},
+		wantResources: []triggersv1alpha1.ResourceReference{
+			{APIVersion: "tekton.dev/v1", Kind: "TaskRun", Namespace: namespace, Name: "git-clone-run"},
+		},
+	}, {
+		name:       "interceptor stopped",
+		body:       `{"action": "closed"}`,
+		wantStatus: corev1.ConditionTrue,
+		wantReason: triggersv1alpha1.TriggerRunReasonInterceptorStopped,
+		wantInterceptors: []triggersv1alpha1.InterceptorResult{
+			{Name: "cel", Message: `expression body.action == "opened" did not return true`},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-el",
+					Namespace: namespace,
+					UID:       types.UID(elUID),
+				},
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						Name: "git-clone-trigger",
+						Interceptors: []*triggersv1beta1.EventInterceptor{{
+							Ref: triggersv1beta1.InterceptorRef{
+								Name: "cel",
+								Kind: triggersv1beta1.ClusterInterceptorKind,
+							},
+							Params: []triggersv1beta1.InterceptorParams{
+								{Name: "filter", Value: test.ToV1JSON(t, `body.action == "opened"`)},
+							},
+						}},
+						Bindings: []*triggersv1beta1.EventListenerBinding{
+							{Name: "url", Value: ptr.String("$(body.repository.url)")},
+							{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+						},
+						Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-run")},
+					}},
+					TriggerRuns: &triggersv1beta1.TriggerRuns{RecordParams: tc.recordParams},
+				},
+			}
+			sink, _ := getSinkAssets(t, test.Resources{
+				EventListeners:      []*triggersv1beta1.EventListener{el},
+				ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{cel},
+			}, el.Name, nil)
+
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader([]byte(tc.body)))
+			if err != nil {
+				t.Fatalf("error sending request: %s", err)
+			}
+			resp.Body.Close()
+			sink.WGProcessTriggers.Wait()
+
+			trs, err := sink.TriggersClient.TriggersV1alpha1().TriggerRuns(namespace).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("error listing TriggerRuns: %v", err)
+			}
+			if len(trs.Items) != 1 {
+				t.Fatalf("got %d TriggerRuns, want 1", len(trs.Items))
+			}
+			tr := trs.Items[0]
+
+			wantLabels := map[string]string{
+				triggers.GroupName + triggers.EventListenerLabelKey: el.Name,
+				triggers.GroupName + triggers.EventIDLabelKey:       eventID,
+				triggers.GroupName + triggers.TriggerLabelKey:       "git-clone-trigger",
+			}
+			if diff := cmp.Diff(wantLabels, tr.Labels); diff != "" {
+				t.Errorf("labels (-want, +got): %s", diff)
+			}
+			if tr.GenerateName != "git-clone-trigger-" {
+				t.Errorf("got GenerateName %q, want %q", tr.GenerateName, "git-clone-trigger-")
+			}
+			if len(tr.OwnerReferences) != 1 || tr.OwnerReferences[0].UID != el.UID || tr.OwnerReferences[0].Kind != "EventListener" {
+				t.Errorf("got owner references %+v, want the EventListener", tr.OwnerReferences)
+			}
+			wantSpec := triggersv1alpha1.TriggerRunSpec{
+				EventListener:    el.Name,
+				EventID:          eventID,
+				Trigger:          "git-clone-trigger",
+				TriggerNamespace: namespace,
+			}
+			if diff := cmp.Diff(wantSpec, tr.Spec); diff != "" {
+				t.Errorf("spec (-want, +got): %s", diff)
+			}
+
+			cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+			if cond == nil || cond.Status != tc.wantStatus || cond.Reason != tc.wantReason {
+				t.Errorf("got condition %+v, want status %s and reason %s", cond, tc.wantStatus, tc.wantReason)
+			}
+			if tr.Status.StartTime == nil || tr.Status.CompletionTime == nil || tr.Status.CompletionTime.Before(tr.Status.StartTime) {
+				t.Errorf("got start time %v and completion time %v", tr.Status.StartTime, tr.Status.CompletionTime)
+			}
+			if diff := cmp.Diff(tc.wantInterceptors, tr.Status.Interceptors); diff != "" {
+				t.Errorf("interceptors (-want, +got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantParams, tr.Status.Params, cmpopts.SortSlices(func(a, b triggersv1alpha1.Param) bool { return a.Name < b.Name })); diff != "" {
+				t.Errorf("params (-want, +got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantResources, tr.Status.Resources); diff != "" {
+				t.Errorf("resources (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStartTriggerRun(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			TriggerRuns: &triggersv1beta1.TriggerRuns{},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	trigger := triggersv1beta1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "my-trigger", Namespace: namespace}}
+
+	started := sink.startTriggerRun(el, trigger, "", eventID, sink.Logger)
+	if started == nil {
+		t.Fatal("startTriggerRun() = nil, want a TriggerRun")
+	}
+
+	// The TriggerRun is marked as running before the Trigger is processed
+	tr, err := sink.TriggersClient.TriggersV1alpha1().TriggerRuns(namespace).Get(context.Background(), started.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting TriggerRun: %v", err)
+	}
+	cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+	if cond == nil || cond.Status != corev1.ConditionUnknown || cond.Reason != triggersv1alpha1.TriggerRunReasonRunning {
+		t.Errorf("got condition %+v, want status Unknown and reason %s", cond, triggersv1alpha1.TriggerRunReasonRunning)
+	}
+	if tr.Status.StartTime == nil || tr.Status.CompletionTime != nil {
+		t.Errorf("got start time %v and completion time %v, want only a start time", tr.Status.StartTime, tr.Status.CompletionTime)
+	}
+}
+
+func TestDeleteExpiredTriggerRuns(t *testing.T) {
+	sink, _ := getSinkAssets(t, test.Resources{}, "my-el", nil)
+	triggerRuns := sink.TriggersClient.TriggersV1alpha1().TriggerRuns(namespace)
+	now := time.Now()
+	for _, tr := range []struct {
+		name      string
+		el        string
+		created   time.Time
+		completed *time.Time
+	}{
+		{name: "completed-recently", el: "my-el", created: now.Add(-2 * time.Hour), completed: ptr.Time(now.Add(-time.Minute))},
+		{name: "completed-long-ago", el: "my-el", created: now.Add(-2 * time.Hour), completed: ptr.Time(now.Add(-90 * time.Minute))},
+		{name: "never-completed", el: "my-el", created: now.Add(-2 * time.Hour)},
+		{name: "running", el: "my-el", created: now.Add(-time.Minute)},
+		{name: "other-el", el: "other-el", created: now.Add(-2 * time.Hour)},
+	} {
+		run := &triggersv1alpha1.TriggerRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              tr.name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(tr.created),
+				Labels:            map[string]string{triggers.GroupName + triggers.EventListenerLabelKey: tr.el},
+			},
+		}
+		if tr.completed != nil {
+			run.Status.CompletionTime = &metav1.Time{Time: *tr.completed}
+		}
+		if _, err := triggerRuns.Create(context.Background(), run, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("error creating TriggerRun: %v", err)
+		}
+	}
+
+	if err := sink.DeleteExpiredTriggerRuns(context.Background(), time.Hour); err != nil {
+		t.Fatalf("DeleteExpiredTriggerRuns() = %v", err)
+	}
+
+	list, err := triggerRuns.List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("error listing TriggerRuns: %v", err)
+	}
+	var names []string
+	for _, tr := range list.Items {
+		names = append(names, tr.Name)
+	}
+	if diff := cmp.Diff([]string{"completed-recently", "other-el", "running"}, names, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("remaining TriggerRuns (-want, +got): %s", diff)
+	}
+}
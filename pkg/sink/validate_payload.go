@@ -19,9 +19,12 @@ package sink
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/tektoncd/triggers/pkg/template"
 )
 
 func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
@@ -34,9 +37,9 @@ func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
 			response.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		if r.PayloadValidation {
-			var event map[string]interface{}
-			if err := json.Unmarshal(payload, &event); err != nil {
+		// The CloudEvents of batches are validated one by one
+		if r.PayloadValidation && !isCloudEventBatch(request) {
+			if err := validatePayload(payload, request.Header); err != nil {
 				errMsg := fmt.Sprintf("Invalid event body format : %s", err)
 				r.recordCountMetrics(failTag)
 				r.Logger.Error(errMsg)
@@ -56,3 +59,16 @@ func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
 		eventHandler.ServeHTTP(response, request)
 	})
 }
+
+// validatePayload returns an error if the payload, decoded according to its
+// Content-Type, is not an object.
+func validatePayload(payload []byte, header http.Header) error {
+	data, err := template.DecodeBody(payload, header)
+	if err != nil {
+		return err
+	}
+	if _, ok := data.(map[string]interface{}); !ok {
+		return errors.New("the body is not an object")
+	}
+	return nil
+}
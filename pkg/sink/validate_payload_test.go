@@ -36,6 +36,7 @@ func TestSink_IsValidPayload(t *testing.T) {
 		name           string
 		testResources  test.Resources
 		eventBody      []byte
+		contentType    string
 		wantStatusCode int
 	}{{
 		name: "event with Json Body",
@@ -71,6 +72,24 @@ func TestSink_IsValidPayload(t *testing.T) {
 		},
 		eventBody:      []byte(`<test>xml</test>`),
 		wantStatusCode: http.StatusBadRequest,
+	}, {
+		name: "event with XML Body",
+		testResources: test.Resources{
+			EventListeners: []*triggersv1.EventListener{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      defaultELName,
+					Namespace: namespace,
+				},
+				Spec: triggersv1.EventListenerSpec{
+					Triggers: []triggersv1.EventListenerTrigger{{
+						TriggerRef: "test",
+					}},
+				},
+			}},
+		},
+		eventBody:      []byte(`<test>xml</test>`),
+		contentType:    "application/xml",
+		wantStatusCode: http.StatusAccepted,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			elName := defaultELName
@@ -90,7 +109,11 @@ func TestSink_IsValidPayload(t *testing.T) {
 			ts := httptest.NewServer(sink.IsValidPayload(http.HandlerFunc(sink.HandleEvent)))
 			defer ts.Close()
 
-			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(tc.eventBody))
+			contentType := "application/json"
+			if tc.contentType != "" {
+				contentType = tc.contentType
+			}
+			resp, err := http.Post(ts.URL, contentType, bytes.NewReader(tc.eventBody))
 			if err != nil {
 				t.Fatalf("error making request to eventListener: %s", err)
 			}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	triggersdynamic "github.com/tektoncd/triggers/pkg/dynamic"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WatchEventSource is the EventSourceHeader of the events of watch sources.
+const WatchEventSource = "watch"
+
+// watchSourceTick is how often the EventListener starts and stops the watches
+// of its watch sources when they change.
+var watchSourceTick = 10 * time.Second
+
+// WatchEvent is the event generated for a change of a resource watched by a
+// watch source.
+type WatchEvent struct {
+	Source    string                    `json:"source"`
+	Type      triggersv1.WatchEventType `json:"type"`
+	Object    map[string]interface{}    `json:"object"`
+	OldObject map[string]interface{}    `json:"oldObject,omitempty"`
+}
+
+// runningWatch is the watch of a watch source.
+type runningWatch struct {
+	spec   triggersv1.WatchSource
+	cancel context.CancelFunc
+}
+
+// RunWatchSources watches the resources of the watch sources of the
+// EventListener until the context is done.
+func (r Sink) RunWatchSources(ctx context.Context) {
+	running := map[string]*runningWatch{}
+	defer func() {
+		for _, w := range running {
+			w.cancel()
+		}
+	}()
+	ticker := time.NewTicker(watchSourceTick)
+	defer ticker.Stop()
+	for {
+		r.syncWatchSources(ctx, running)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncWatchSources starts the watches of the new watch sources, and stops the
+// watches of the watch sources that changed or were removed.
+func (r Sink) syncWatchSources(ctx context.Context, running map[string]*runningWatch) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		r.Logger.Errorf("Error getting EventListener %s in Namespace %s: %s", r.EventListenerName, r.EventListenerNamespace, err)
+		return
+	}
+	wanted := map[string]*triggersv1.WatchSource{}
+	for _, s := range el.Spec.Sources {
+		if s.Watch != nil {
+			wanted[s.Name] = s.Watch
+		}
+	}
+	for name, w := range running {
+		if spec, ok := wanted[name]; !ok || !equality.Semantic.DeepEqual(*spec, w.spec) {
+			w.cancel()
+			delete(running, name)
+		}
+	}
+	for name, spec := range wanted {
+		if _, ok := running[name]; ok {
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		if err := r.startWatch(watchCtx, el, name, spec.DeepCopy()); err != nil {
+			cancel()
+			r.Logger.With(zap.String("source", name)).Errorf("failed to watch %s: %v", spec.GroupVersionResource(), err)
+			continue
+		}
+		running[name] = &runningWatch{spec: *spec.DeepCopy(), cancel: cancel}
+	}
+}
+
+// startWatch watches the resources of the watch source of the EventListener
+// until the context is done. The resources that exist when the watch starts do
+// not generate events.
+func (r Sink) startWatch(ctx context.Context, el *triggersv1.EventListener, name string, w *triggersv1.WatchSource) error {
+	gvr := w.GroupVersionResource()
+	namespaced, err := r.isNamespaced(gvr)
+	if err != nil {
+		return err
+	}
+	namespace := ""
+	if namespaced {
+		namespace = w.Namespace
+		if namespace == "" {
+			namespace = r.EventListenerNamespace
+		}
+	}
+	labelSelector := ""
+	if w.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(w.LabelSelector)
+		if err != nil {
+			return err
+		}
+		labelSelector = selector.String()
+	}
+
+	informer := triggersdynamic.NewFilteredInformer(r.DynamicClient, gvr, namespace, labelSelector, w.FieldSelector)
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				r.fireWatchEvent(ctx, el, name, w, triggersv1.WatchEventAdd, obj, nil)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			r.fireWatchEvent(ctx, el, name, w, triggersv1.WatchEventUpdate, newObj, oldObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			r.fireWatchEvent(ctx, el, name, w, triggersv1.WatchEventDelete, obj, nil)
+		},
+	}); err != nil {
+		return err
+	}
+	r.Logger.With(zap.String("source", name)).Infof("watching %s", gvr)
+	go informer.RunWithContext(ctx)
+	return nil
+}
+
+// isNamespaced returns true if the resources of the GroupVersionResource are
+// namespaced.
+func (r Sink) isNamespaced(gvr schema.GroupVersionResource) (bool, error) {
+	resources, err := r.DiscoveryClient.ServerResourcesForGroupVersion(gvr.GroupVersion().String())
+	if err != nil {
+		return false, err
+	}
+	for _, res := range resources.APIResources {
+		if res.Name == gvr.Resource {
+			return res.Namespaced, nil
+		}
+	}
+	return false, fmt.Errorf("resource %s not found", gvr)
+}
+
+// fireWatchEvent processes the change of a resource like the events received
+// by the EventListener on the /sources/<name> path. Each change is recorded in
+// the DeliveryStore so that a single replica processes it.
+func (r Sink) fireWatchEvent(ctx context.Context, el *triggersv1.EventListener, name string, w *triggersv1.WatchSource, eventType triggersv1.WatchEventType, obj, oldObj interface{}) {
+	if !w.Handles(eventType) {
+		return
+	}
+	if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = d.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	e := WatchEvent{Source: name, Type: eventType, Object: u.Object}
+	if old, ok := oldObj.(*unstructured.Unstructured); ok {
+		// Resyncs of the watch report unchanged resources as updates
+		if old.GetResourceVersion() == u.GetResourceVersion() {
+			return
+		}
+		e.OldObject = old.Object
+	}
+
+	log := r.Logger.With(zap.String("source", name))
+	change := fmt.Sprintf("%s %s/%s at resource version %s", eventType, u.GetNamespace(), u.GetName(), u.GetResourceVersion())
+	if r.DeliveryStore != nil {
+		key := fmt.Sprintf("watch/%s/%s/%s/%s", name, eventType, u.GetUID(), u.GetResourceVersion())
+		if _, duplicate, err := r.DeliveryStore.Record(ctx, el, key, "", triggersv1.DefaultDeduplicationTTL); err != nil {
+			log.Errorf("unable to record the %s, processing it: %v", change, err)
+		} else if duplicate {
+			log.Debugf("the %s was processed by another replica", change)
+			return
+		}
+	}
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		log.Errorf("failed to generate the event of the %s: %v", change, err)
+		return
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/sources/"+name, bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("failed to generate the event of the %s: %v", change, err)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(EventSourceHeader, WatchEventSource)
+	log.Infof("generating the event of the %s", change)
+
+	response := &discardResponseWriter{header: http.Header{}}
+	r.HandleEvent(response, request)
+	if response.status >= http.StatusBadRequest {
+		log.Errorf("the event of the %s was rejected with status %d", change, response.status)
+	}
+}
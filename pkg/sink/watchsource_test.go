@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"knative.dev/pkg/ptr"
+)
+
+func TestRunWatchSources(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+	taskRun := func(name, phase, resourceVersion string) *unstructured.Unstructured {
+		tr := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "tekton.dev/v1",
+			"kind":       "TaskRun",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+				"uid":       name,
+				"labels":    map[string]interface{}{"app": "build", "phase": phase},
+			},
+		}}
+		tr.SetResourceVersion(resourceVersion)
+		return tr
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "taskrun-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.type)")},
+					{Name: "revision", Value: ptr.String("$(body.object.metadata.labels.phase)")},
+					{Name: "name", Value: ptr.String("$(body.object.metadata.name)-$(body.oldObject.metadata.labels.phase)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Spec: makeGitCloneTTSpec(t, "")},
+				Match:    &triggersv1beta1.RequestMatch{Path: "/sources/taskruns"},
+			}},
+			Sources: []triggersv1beta1.EventSource{{
+				Name: "taskruns",
+				Watch: &triggersv1beta1.WatchSource{
+					Group:         "tekton.dev",
+					Version:       "v1",
+					Resource:      "taskruns",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "build"}},
+					EventTypes:    []triggersv1beta1.WatchEventType{triggersv1beta1.WatchEventUpdate},
+				},
+			}},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "TaskRunList"},
+		taskRun("build", "running", "1"))
+	sink.DynamicClient = dynamicClient
+	sink.DeliveryStore = newInMemoryDeliveryStore()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sink.RunWatchSources(ctx)
+
+	// Wait for the watch to start, since the resources listed before do not
+	// generate events
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		for _, a := range dynamicClient.Actions() {
+			if a.GetVerb() == "watch" {
+				return true, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		t.Fatalf("the resources of the source were not watched: %v", err)
+	}
+
+	resource := dynamicClient.Resource(gvr).Namespace(namespace)
+	if _, err := resource.Update(ctx, taskRun("build", "succeeded", "2"), metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error updating the TaskRun: %v", err)
+	}
+	var tr *unstructured.Unstructured
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		var err error
+		tr, err = resource.Get(ctx, "build-running", metav1.GetOptions{})
+		return err == nil, nil
+	}); err != nil {
+		t.Fatalf("the TaskRun of the update was not created: %v", err)
+	}
+	sink.WGProcessTriggers.Wait()
+	params, _, _ := unstructured.NestedSlice(tr.Object, "spec", "params")
+	wantParams := []interface{}{
+		map[string]interface{}{"name": "url", "value": "update"},
+		map[string]interface{}{"name": "git-revision", "value": "succeeded"},
+	}
+	if diff := cmp.Diff(wantParams, params); diff != "" {
+		t.Errorf("params of the TaskRun (-want, +got): %s", diff)
+	}
+
+	// The same change seen by another replica is not processed again
+	creates := len(dynamicClient.Actions())
+	sink.fireWatchEvent(ctx, el, "taskruns", el.Spec.Sources[0].Watch, triggersv1beta1.WatchEventUpdate,
+		taskRun("build", "succeeded", "2"), taskRun("build", "running", "1"))
+	sink.WGProcessTriggers.Wait()
+	if got := len(dynamicClient.Actions()); got != creates {
+		t.Errorf("got %d more actions, want the change to be processed once", got-creates)
+	}
+}
+
+func TestSyncWatchSources(t *testing.T) {
+	source := triggersv1beta1.EventSource{
+		Name:  "taskruns",
+		Watch: &triggersv1beta1.WatchSource{Version: "v1", Resource: "taskruns", Group: "tekton.dev"},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{Name: "trigger"}},
+			Sources:  []triggersv1beta1.EventSource{source},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cancelled := false
+	running := map[string]*runningWatch{
+		"removed": {spec: *source.Watch, cancel: func() { cancelled = true }},
+	}
+	sink.syncWatchSources(ctx, running)
+	if !cancelled {
+		t.Error("the watch of the removed source was not stopped")
+	}
+	if _, ok := running["taskruns"]; !ok || len(running) != 1 {
+		t.Errorf("got running watches %v, want the watch of the source", running)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"sync"
+)
+
+// WorkerPool bounds the number of Triggers and TriggerGroups processed at the
+// same time, and the number waiting to be processed. A nil WorkerPool does not
+// bound anything.
+type WorkerPool struct {
+	workers   chan struct{}
+	queueSize int
+
+	mu      sync.Mutex
+	pending int
+	running int
+}
+
+// NewWorkerPool returns a WorkerPool that processes at most maxConcurrency
+// Triggers and TriggerGroups at the same time, with at most queueSize waiting.
+// It returns nil if maxConcurrency is not positive.
+func NewWorkerPool(maxConcurrency, queueSize int) *WorkerPool {
+	if maxConcurrency <= 0 {
+		return nil
+	}
+	return &WorkerPool{
+		workers:   make(chan struct{}, maxConcurrency),
+		queueSize: queueSize,
+	}
+}
+
+// admit reserves n places for the Triggers and TriggerGroups of an event.
+// It returns false, and reserves nothing, if there is not enough room.
+func (p *WorkerPool) admit(n int) bool {
+	if p == nil {
+		return true
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.pending+p.running+n > cap(p.workers)+p.queueSize {
+		return false
+	}
+	p.pending += n
+	recordQueueDepth(int64(n))
+	return true
+}
+
+// enqueue reserves n places whatever the room left. It is used for the
+// Triggers selected by a TriggerGroup, whose event was already admitted.
+func (p *WorkerPool) enqueue(n int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending += n
+	recordQueueDepth(int64(n))
+}
+
+// cancel releases n places reserved for work that will not be run.
+func (p *WorkerPool) cancel(n int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending -= n
+	recordQueueDepth(-int64(n))
+}
+
+// run runs f in a new goroutine once a worker is available. f uses one of the
+// places reserved by admit or enqueue.
+func (p *WorkerPool) run(f func()) {
+	if p == nil {
+		go f()
+		return
+	}
+	go func() {
+		p.workers <- struct{}{}
+		p.mu.Lock()
+		p.pending--
+		p.running++
+		p.mu.Unlock()
+		recordQueueDepth(-1)
+		defer func() {
+			p.mu.Lock()
+			p.running--
+			p.mu.Unlock()
+			<-p.workers
+		}()
+		f()
+	}()
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// counts returns the number of pending and running triggers of the pool.
+func (p *WorkerPool) counts() (pending, running int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.pending, p.running
+}
+
+func TestWorkerPool(t *testing.T) {
+	p := NewWorkerPool(1, 2)
+
+	if p.admit(4) {
+		t.Fatal("admit(4) = true, want false since only 3 triggers can be in flight")
+	}
+	if !p.admit(2) {
+		t.Fatal("admit(2) = false, want true")
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for range 2 {
+		p.run(func() {
+			defer wg.Done()
+			started <- struct{}{}
+			<-release
+		})
+	}
+	// Only one trigger runs at a time, the other one waits for the worker
+	<-started
+	if !p.admit(1) {
+		t.Fatal("admit(1) = false, want true")
+	}
+	if p.admit(1) {
+		t.Fatal("admit(1) = true, want false since the queue is full")
+	}
+	p.cancel(1)
+
+	// Triggers selected by a trigger group are always queued
+	p.enqueue(1)
+	wg.Add(1)
+	p.run(func() {
+		defer wg.Done()
+		started <- struct{}{}
+		<-release
+	})
+
+	close(release)
+	<-started
+	<-started
+	wg.Wait()
+	// The worker is released once f returns
+	if err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		_, running := p.counts()
+		return running == 0, nil
+	}); err != nil {
+		pending, running := p.counts()
+		t.Fatalf("got %d pending and %d running triggers, want none", pending, running)
+	}
+	if pending, _ := p.counts(); pending != 0 {
+		t.Errorf("got %d pending triggers, want none", pending)
+	}
+}
+
+func TestWorkerPool_Nil(t *testing.T) {
+	p := NewWorkerPool(0, 0)
+	if p != nil {
+		t.Fatalf("NewWorkerPool(0, 0) = %v, want nil", p)
+	}
+	if !p.admit(1000) {
+		t.Error("admit() = false, want true for a nil pool")
+	}
+	done := make(chan struct{})
+	p.run(func() { close(done) })
+	<-done
+}
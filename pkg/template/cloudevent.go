@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// CloudEventContext returns the attributes of the CloudEvent whose ce-*
+// headers are the headers of a request, such as type, source, subject, id and
+// time, with its extensions under extensions. It returns nil if the headers
+// are not those of a valid CloudEvent in binary mode.
+func CloudEventContext(header http.Header) map[string]interface{} {
+	msg := cehttp.NewMessage(header, nil)
+	if msg.ReadEncoding() != binding.EncodingBinary {
+		return nil
+	}
+	e, err := binding.ToEvent(context.Background(), msg)
+	if err != nil {
+		return nil
+	}
+
+	ce := map[string]interface{}{
+		"specversion": e.SpecVersion(),
+		"id":          e.ID(),
+		"source":      e.Source(),
+		"type":        e.Type(),
+	}
+	optional := map[string]string{
+		"subject":         e.Subject(),
+		"dataschema":      e.DataSchema(),
+		"datacontenttype": e.DataContentType(),
+	}
+	if !e.Time().IsZero() {
+		optional["time"] = e.Time().Format(time.RFC3339Nano)
+	}
+	for k, v := range optional {
+		if v != "" {
+			ce[k] = v
+		}
+	}
+	extensions := make(map[string]interface{}, len(e.Extensions()))
+	for k, v := range e.Extensions() {
+		extensions[k] = v
+	}
+	ce["extensions"] = extensions
+	return ce
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// BodyDecoder decodes the bodies of a media type into the value, made of
+// maps, slices, strings, float64 numbers, booleans and nils like decoded
+// JSON, that bindings and CEL expressions access as body.
+type BodyDecoder struct {
+	// Format is the name of the format of the bodies, used in errors.
+	Format string
+	// Decode decodes a body, given the parameters of its media type.
+	Decode func(body []byte, params map[string]string) (interface{}, error)
+}
+
+var (
+	bodyDecodersMu sync.RWMutex
+	bodyDecoders   = map[string]BodyDecoder{}
+	jsonDecoder    = BodyDecoder{Format: "JSON", Decode: decodeJSON}
+)
+
+func init() {
+	xmlDecoder := BodyDecoder{Format: "XML", Decode: decodeXML}
+	yamlDecoder := BodyDecoder{Format: "YAML", Decode: decodeYAML}
+	for mediaType, d := range map[string]BodyDecoder{
+		"application/json":                  jsonDecoder,
+		"application/xml":                   xmlDecoder,
+		"text/xml":                          xmlDecoder,
+		"application/yaml":                  yamlDecoder,
+		"application/x-yaml":                yamlDecoder,
+		"text/yaml":                         yamlDecoder,
+		"application/x-www-form-urlencoded": {Format: "form data", Decode: decodeForm},
+		"multipart/form-data":               {Format: "multipart form data", Decode: decodeMultipart},
+	} {
+		RegisterBodyDecoder(mediaType, d)
+	}
+}
+
+// RegisterBodyDecoder registers the decoder of the bodies of the media type,
+// such as application/xml, replacing the decoder registered before if any.
+func RegisterBodyDecoder(mediaType string, d BodyDecoder) {
+	bodyDecodersMu.Lock()
+	defer bodyDecodersMu.Unlock()
+	bodyDecoders[strings.ToLower(mediaType)] = d
+}
+
+// bodyDecoder returns the decoder of the media type. Structured syntax
+// suffixes, such as +xml, select the decoder of their format, and bodies of
+// other media types are decoded as JSON.
+func bodyDecoder(mediaType string) BodyDecoder {
+	bodyDecodersMu.RLock()
+	defer bodyDecodersMu.RUnlock()
+	if d, ok := bodyDecoders[mediaType]; ok {
+		return d
+	}
+	if i := strings.LastIndex(mediaType, "+"); i >= 0 {
+		if d, ok := bodyDecoders["application/"+mediaType[i+1:]]; ok {
+			return d
+		}
+	}
+	return jsonDecoder
+}
+
+// DecodeBody decodes the body of a request with the decoder of the media type
+// of its Content-Type header. Bodies without a Content-Type header are decoded
+// as JSON. It returns nil for an empty body.
+func DecodeBody(body []byte, header http.Header) (interface{}, error) {
+	if len(body) == 0 {
+		return nil, nil
+	}
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = ""
+	}
+	d := bodyDecoder(mediaType)
+	data, err := d.Decode(body, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the body as %s: %w", d.Format, err)
+	}
+	return data, nil
+}
+
+func decodeJSON(body []byte, _ map[string]string) (interface{}, error) {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func decodeYAML(body []byte, _ map[string]string) (interface{}, error) {
+	b, err := yaml.YAMLToJSON(body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSON(b, nil)
+}
+
+// decodeXML decodes an XML document into a map from the name of its root
+// element to the value of the element.
+func decodeXML(body []byte, _ map[string]string) (interface{}, error) {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			v, err := decodeXMLElement(d, start)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{start.Name.Local: v}, nil
+		}
+	}
+}
+
+// decodeXMLElement returns the value of an XML element: its text if it has
+// neither attributes nor child elements, or else a map of its attributes,
+// prefixed with -, of its child elements, as lists if they are repeated, and
+// of its text as #text.
+func decodeXMLElement(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	m := map[string]interface{}{}
+	for _, a := range start.Attr {
+		m["-"+a.Name.Local] = a.Value
+	}
+	var text strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			v, err := decodeXMLElement(d, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing, ok := m[name]; {
+			case !ok:
+				m[name] = v
+			case isList(existing):
+				m[name] = append(existing.([]interface{}), v)
+			default:
+				m[name] = []interface{}{existing, v}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			s := strings.TrimSpace(text.String())
+			if len(m) == 0 {
+				return s, nil
+			}
+			if s != "" {
+				m["#text"] = s
+			}
+			return m, nil
+		}
+	}
+}
+
+func isList(v interface{}) bool {
+	_, ok := v.([]interface{})
+	return ok
+}
+
+// decodeForm decodes form data into a map from the name of each field to the
+// list of its values.
+func decodeForm(body []byte, _ map[string]string) (interface{}, error) {
+	// Interceptors receive the fields of form data as JSON, possibly extended
+	// with the extensions of the interceptors
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err == nil {
+		decodePayloadField(fields)
+		return fields, nil
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	return formFields(values), nil
+}
+
+// decodeMultipart decodes multipart form data like form data. The values of
+// file fields are lists of maps with the filename, contentType and size of
+// each file.
+func decodeMultipart(body []byte, params map[string]string) (interface{}, error) {
+	boundary := params["boundary"]
+	if boundary == "" {
+		return nil, errors.New("missing boundary")
+	}
+	form, err := multipart.NewReader(bytes.NewReader(body), boundary).ReadForm(int64(len(body)))
+	if err != nil {
+		return nil, err
+	}
+	defer form.RemoveAll() //nolint:errcheck
+	fields := formFields(form.Value)
+	for name, files := range form.File {
+		list := make([]interface{}, 0, len(files))
+		for _, f := range files {
+			list = append(list, map[string]interface{}{
+				"filename":    f.Filename,
+				"contentType": f.Header.Get("Content-Type"),
+				"size":        float64(f.Size),
+			})
+		}
+		fields[name] = list
+	}
+	return fields, nil
+}
+
+// formFields returns the lists of values of the fields of a form.
+func formFields(values map[string][]string) map[string]interface{} {
+	fields := make(map[string]interface{}, len(values))
+	for name, vs := range values {
+		list := make([]interface{}, 0, len(vs))
+		for _, v := range vs {
+			list = append(list, v)
+		}
+		fields[name] = list
+	}
+	decodePayloadField(fields)
+	return fields
+}
+
+// decodePayloadField decodes the payload field of a form if it has a single
+// value holding a JSON document, as Slack and some legacy tools send.
+func decodePayloadField(fields map[string]interface{}) {
+	list, ok := fields["payload"].([]interface{})
+	if !ok || len(list) != 1 {
+		return
+	}
+	s, ok := list[0].(string)
+	if !ok {
+		return
+	}
+	var payload interface{}
+	if err := json.Unmarshal([]byte(s), &payload); err == nil {
+		fields["payload"] = payload
+	}
+}
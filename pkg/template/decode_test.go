@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+func multipartBody(t *testing.T) ([]byte, string) {
+	t.Helper()
+	var b bytes.Buffer
+	w := multipart.NewWriter(&b)
+	if err := w.WriteField("ref", "main"); err != nil {
+		t.Fatal(err)
+	}
+	f, err := w.CreateFormFile("report", "report.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte("passed")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes(), w.FormDataContentType()
+}
+
+func TestDecodeBody(t *testing.T) {
+	multipart, multipartType := multipartBody(t)
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		want        interface{}
+	}{{
+		name: "empty body",
+		want: nil,
+	}, {
+		name: "JSON without Content-Type",
+		body: `{"ref": "main", "commits": [1, 2]}`,
+		want: map[string]interface{}{"ref": "main", "commits": []interface{}{1.0, 2.0}},
+	}, {
+		name:        "JSON structured syntax suffix",
+		body:        `{"ref": "main"}`,
+		contentType: "application/vnd.api+json; charset=utf-8",
+		want:        map[string]interface{}{"ref": "main"},
+	}, {
+		name:        "YAML",
+		body:        "ref: main\ncommits:\n- 1\n- 2\n",
+		contentType: "application/yaml",
+		want:        map[string]interface{}{"ref": "main", "commits": []interface{}{1.0, 2.0}},
+	}, {
+		name:        "XML",
+		body:        `<?xml version="1.0"?><push ref="main"><commit>a</commit><commit>b</commit><repository><name>triggers</name></repository> text </push>`,
+		contentType: "application/xml",
+		want: map[string]interface{}{"push": map[string]interface{}{
+			"-ref":       "main",
+			"commit":     []interface{}{"a", "b"},
+			"repository": map[string]interface{}{"name": "triggers"},
+			"#text":      "text",
+		}},
+	}, {
+		name:        "XML structured syntax suffix",
+		body:        `<feed><title>releases</title></feed>`,
+		contentType: "application/atom+xml",
+		want:        map[string]interface{}{"feed": map[string]interface{}{"title": "releases"}},
+	}, {
+		name:        "form data",
+		body:        "ref=main&label=a&label=b",
+		contentType: "application/x-www-form-urlencoded",
+		want:        map[string]interface{}{"ref": []interface{}{"main"}, "label": []interface{}{"a", "b"}},
+	}, {
+		name:        "form data converted to JSON",
+		body:        `{"ref": ["main"]}`,
+		contentType: "application/x-www-form-urlencoded",
+		want:        map[string]interface{}{"ref": []interface{}{"main"}},
+	}, {
+		name:        "form data converted to JSON with extensions",
+		body:        `{"payload": ["{\"action\": \"opened\"}"], "extensions": {"truncated_sha": "a1b2"}}`,
+		contentType: "application/x-www-form-urlencoded",
+		want: map[string]interface{}{
+			"payload":    map[string]interface{}{"action": "opened"},
+			"extensions": map[string]interface{}{"truncated_sha": "a1b2"},
+		},
+	}, {
+		name:        "form data with a JSON payload",
+		body:        `payload=%7B%22action%22%3A%22opened%22%7D&token=abc`,
+		contentType: "application/x-www-form-urlencoded",
+		want:        map[string]interface{}{"payload": map[string]interface{}{"action": "opened"}, "token": []interface{}{"abc"}},
+	}, {
+		name:        "multipart form data",
+		body:        string(multipart),
+		contentType: multipartType,
+		want: map[string]interface{}{
+			"ref": []interface{}{"main"},
+			"report": []interface{}{map[string]interface{}{
+				"filename":    "report.txt",
+				"contentType": "application/octet-stream",
+				"size":        6.0,
+			}},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.contentType != "" {
+				header.Set("Content-Type", tt.contentType)
+			}
+			got, err := DecodeBody([]byte(tt.body), header)
+			if err != nil {
+				t.Fatalf("DecodeBody() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DecodeBody() (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeBody_Error(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		wantErr     string
+	}{{
+		name:    "invalid JSON",
+		body:    `{"tes`,
+		wantErr: "failed to parse the body as JSON: unexpected end of JSON input",
+	}, {
+		name:        "invalid XML",
+		body:        `<push><ref>main</push>`,
+		contentType: "text/xml",
+		wantErr:     "failed to parse the body as XML",
+	}, {
+		name:        "invalid YAML",
+		body:        "ref: [main",
+		contentType: "application/x-yaml",
+		wantErr:     "failed to parse the body as YAML",
+	}, {
+		name:        "multipart form data without boundary",
+		body:        "--x\r\n",
+		contentType: "multipart/form-data",
+		wantErr:     "failed to parse the body as multipart form data: missing boundary",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.contentType != "" {
+				header.Set("Content-Type", tt.contentType)
+			}
+			_, err := DecodeBody([]byte(tt.body), header)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("DecodeBody() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegisterBodyDecoder(t *testing.T) {
+	RegisterBodyDecoder("text/plain", BodyDecoder{
+		Format: "text",
+		Decode: func(body []byte, params map[string]string) (interface{}, error) {
+			return map[string]interface{}{"text": string(body), "charset": params["charset"]}, nil
+		},
+	})
+	defer func() {
+		bodyDecodersMu.Lock()
+		delete(bodyDecoders, "text/plain")
+		bodyDecodersMu.Unlock()
+	}()
+
+	header := http.Header{"Content-Type": {"text/plain; charset=utf-8"}}
+	params, err := applyEventValuesToParams([]triggersv1.Param{{Name: "text", Value: "$(body.text) in $(body.charset)"}},
+		[]byte("build passed"), header, nil, nil, TriggerContext{})
+	if err != nil {
+		t.Fatalf("applyEventValuesToParams() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]triggersv1.Param{{Name: "text", Value: "build passed in utf-8"}}, params); diff != "" {
+		t.Errorf("applyEventValuesToParams() (-want,+got): %s", diff)
+	}
+}
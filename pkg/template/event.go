@@ -60,6 +60,16 @@ func ResolveParams(rt ResolvedTrigger, body []byte, header http.Header, extensio
 	return out, nil
 }
 
+// ResolveValue replaces the $() expressions in value, such as $(body.id) or
+// $(header.X-Github-Delivery), with values from the event.
+func ResolveValue(value string, body []byte, header http.Header, extensions map[string]interface{}, triggerContext TriggerContext) (string, error) {
+	params, err := applyEventValuesToParams([]triggersv1.Param{{Name: "value", Value: value}}, body, header, extensions, nil, triggerContext)
+	if err != nil {
+		return "", err
+	}
+	return params[0].Value, nil
+}
+
 // ResolveResources resolves a templated resource by replacing params with their values.
 func ResolveResources(template *triggersv1.TriggerTemplate, params []triggersv1.Param) []json.RawMessage {
 	resources := make([]json.RawMessage, len(template.Spec.ResourceTemplates))
@@ -80,15 +90,15 @@ type event struct {
 	Body       interface{}            `json:"body"`
 	Extensions map[string]interface{} `json:"extensions"`
 	Context    TriggerContext         `json:"context"`
+	// CE holds the attributes of the event if it is a CloudEvent.
+	CE map[string]interface{} `json:"ce,omitempty"`
 }
 
 // newEvent returns a new Event from HTTP headers and body
 func newEvent(body []byte, headers http.Header, extensions map[string]interface{}, triggerContext TriggerContext) (*event, error) {
-	var data interface{}
-	if len(body) > 0 {
-		if err := json.Unmarshal(body, &data); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal request body: %w", err)
-		}
+	data, err := DecodeBody(body, headers)
+	if err != nil {
+		return nil, err
 	}
 	joinedHeaders := make(map[string]string, len(headers))
 	for k, v := range headers {
@@ -100,6 +110,7 @@ func newEvent(body []byte, headers http.Header, extensions map[string]interface{
 		Body:       data,
 		Extensions: extensions,
 		Context:    triggerContext,
+		CE:         CloudEventContext(headers),
 	}, nil
 }
 
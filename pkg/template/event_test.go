@@ -302,6 +302,32 @@ func TestApplyEventValuesToParams(t *testing.T) {
 		},
 		params: []triggersv1.Param{{Name: "a", Value: "$(extensions.foo)"}},
 		want:   []triggersv1.Param{{Name: "a", Value: `[{"a":"1"},{"b":"2"}]`}},
+	}, {
+		name: "CloudEvent attributes",
+		body: []byte(`{"id": "build-1"}`),
+		header: map[string][]string{
+			"Ce-Specversion": {"1.0"},
+			"Ce-Id":          {"event-1"},
+			"Ce-Source":      {"/builds"},
+			"Ce-Type":        {"dev.tekton.build.finished"},
+			"Ce-Time":        {"2026-01-02T03:04:05Z"},
+			"Ce-Team":        {"ci"},
+			"Content-Type":   {"application/json"},
+		},
+		params: []triggersv1.Param{
+			{Name: "type", Value: "$(ce.type)"},
+			{Name: "source", Value: "$(ce.source)"},
+			{Name: "time", Value: "$(ce.time)"},
+			{Name: "team", Value: "$(ce.extensions.team)"},
+			{Name: "id", Value: "$(body.id)"},
+		},
+		want: []triggersv1.Param{
+			{Name: "type", Value: "dev.tekton.build.finished"},
+			{Name: "source", Value: "/builds"},
+			{Name: "time", Value: "2026-01-02T03:04:05Z"},
+			{Name: "team", Value: "ci"},
+			{Name: "id", Value: "build-1"},
+		},
 	}}
 
 	for _, tt := range tests {
@@ -331,6 +357,11 @@ func TestApplyEventValuesToParams_Error(t *testing.T) {
 		name:   "missing key",
 		params: []triggersv1.Param{{Name: "foo", Value: "$(body.missing)"}},
 		body:   json.RawMessage(`{}`),
+	}, {
+		name:   "not a CloudEvent",
+		params: []triggersv1.Param{{Name: "foo", Value: "$(ce.type)"}},
+		body:   json.RawMessage(`{}`),
+		header: map[string][]string{"Ce-Type": {"dev.tekton.build.finished"}},
 	}, {
 		name:   "non JSON body",
 		params: []triggersv1.Param{{Name: "foo", Value: "$(body)"}},
@@ -519,6 +550,48 @@ func TestResolveParams_Error(t *testing.T) {
 	}
 }
 
+func TestResolveValue(t *testing.T) {
+	body := json.RawMessage(`{"repository": {"name": "triggers"}, "id": 42}`)
+	header := map[string][]string{"X-Github-Delivery": {"72d3162e"}}
+
+	for _, tc := range []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{{
+		name:  "header",
+		value: "$(header.X-Github-Delivery)",
+		want:  "72d3162e",
+	}, {
+		name:  "body with surrounding text",
+		value: "$(body.repository.name)-$(body.id)",
+		want:  "triggers-42",
+	}, {
+		name:  "context",
+		value: "$(context.eventID)",
+		want:  "1234567",
+	}, {
+		name:  "literal",
+		value: "constant",
+		want:  "constant",
+	}, {
+		name:    "missing field",
+		value:   "$(body.missing)",
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveValue(tc.value, body, header, nil, NewTriggerContext("1234567"))
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ResolveValue() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ResolveValue() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
 func addOldEscape(t *triggersv1.TriggerTemplate) *triggersv1.TriggerTemplate {
 	t.Annotations = map[string]string{
 		OldEscapeAnnotation: "yes",